@@ -14,13 +14,104 @@ import (
 )
 
 const (
-	DefaultFormat   = ""
-	DefaultLanguage = ""
-	DefaultPretty   = false
-	DefaultStride   = 10000
-	DefaultTitle    = ""
+	DefaultEntryMode     = false
+	DefaultExtractors    = ""
+	DefaultFormat        = ""
+	DefaultGaiji         = ""
+	DefaultGaijiImg      = ""
+	DefaultKanjiData     = ""
+	DefaultKanjidic      = ""
+	DefaultLanguage      = ""
+	DefaultListSubbooks  = false
+	DefaultNameTypes     = ""
+	DefaultPitch         = ""
+	DefaultPlain         = false
+	DefaultPretty        = false
+	DefaultRuleSet       = ""
+	DefaultScoring       = ""
+	DefaultSkipUnknown   = false
+	DefaultSplit         = false
+	DefaultSplitSubbooks = false
+	DefaultStats         = false
+	DefaultStride        = 10000
+	DefaultSubbooks      = ""
+	DefaultTitle         = ""
 )
 
+type ExportOptions struct {
+	// Deinflection rule set for conjugating terms ("legacy" or "extended").
+	RuleSet string
+
+	// Build one JMdict term per headword containing all of its senses.
+	EntryMode bool
+
+	// Path to a JSON scoring profile for JMdict, JMnedict and Rikai terms.
+	ScoringPath string
+
+	// Print the distribution of term scores after building.
+	ScoreStats bool
+
+	// Comma-separated JMnedict name types or name type groups
+	// (people, places, organizations, works, other) to include.
+	NameTypes string
+
+	// Write one JMnedict dictionary per item of NameTypes into the
+	// output directory instead of a single filtered dictionary.
+	SplitNameTypes bool
+
+	// Comma-separated groups of optional KANJIDIC2 data to export
+	// (nanori, radicals, readings, variants, strokes).
+	KanjiData string
+
+	// Path to the KANJIDIC2 file cross-referenced with JMdict when
+	// building the kanji vocabulary dictionary.
+	KanjidicPath string
+
+	// JSON gaiji table, or directory of them, supplementing and
+	// overriding the built-in EPWING gaiji tables.
+	GaijiPath string
+
+	// Directory of gaiji images extracted from an EPWING book, used
+	// for characters which have no Unicode replacement.
+	GaijiImagePath string
+
+	// JSON EPWING extractor definition, or directory of them, adding
+	// books or replacing the extractors of built-in ones.
+	ExtractorPath string
+
+	// Comma-separated titles or 1-based indices of the EPWING
+	// subbooks to convert; all subbooks are converted if empty.
+	Subbooks string
+
+	// Print the subbooks of an EPWING book instead of converting it.
+	ListSubbooks bool
+
+	// Write one dictionary per EPWING subbook into the output
+	// directory, each with its own title and revision.
+	SplitSubbooks bool
+
+	// Export the pitch accent data of EPWING books into the dictionary
+	// ("include") or into a companion dictionary ("companion").
+	Pitch string
+
+	// Skip EPWING subbooks without a dedicated extractor instead of
+	// converting them with the generic extractor.
+	SkipUnknownSubbooks bool
+
+	// Keep the definitions of EPWING books as plain text instead of
+	// rendering their senses and examples as structured content.
+	PlainDefinitions bool
+
+	scoring *scoringProfile
+}
+
+func (options ExportOptions) scoringProfile() scoringProfile {
+	if options.scoring == nil {
+		return defaultScoringProfile()
+	}
+	return *options.scoring
+}
+
 type dbRecord []any
 type dbRecordList []dbRecord
 
@@ -165,7 +256,18 @@ func (index *dbIndex) setDefaults() {
 	}
 }
 
+// A binary file, such as an image, stored in the dictionary archive
+// alongside the JSON banks.
+type dbMedia struct {
+	Path string
+	Data []byte
+}
+
 func writeDb(outputPath string, index dbIndex, recordData map[string]dbRecordList, stride int, pretty bool) error {
+	return writeDbMedia(outputPath, index, recordData, nil, stride, pretty)
+}
+
+func writeDbMedia(outputPath string, index dbIndex, recordData map[string]dbRecordList, media []dbMedia, stride int, pretty bool) error {
 	var zbuff bytes.Buffer
 	zip := zip.NewWriter(&zbuff)
 
@@ -216,6 +318,17 @@ func writeDb(outputPath string, index dbIndex, recordData map[string]dbRecordLis
 		}
 	}
 
+	for _, file := range media {
+		zw, err := zip.Create(file.Path)
+		if err != nil {
+			return err
+		}
+
+		if _, err := zw.Write(file.Data); err != nil {
+			return err
+		}
+	}
+
 	index.setDefaults()
 	bytes, err := marshalJSON(index, pretty)
 	if err != nil {
@@ -305,6 +418,8 @@ func detectFormat(path string) (string, error) {
 		return "enamdict", nil
 	case "kanjidic2", "kanjidic2.xml":
 		return "kanjidic", nil
+	case "kradfile", "kradfile2", "radkfile", "radkfile2":
+		return "radkfile", nil
 	}
 
 	info, err := os.Stat(path)
@@ -322,21 +437,32 @@ func detectFormat(path string) (string, error) {
 		if err == nil {
 			return "epwing", nil
 		}
+
+		for _, name := range append(kradfileNames, radkfileNames...) {
+			if _, err := os.Stat(filepath.Join(path, name)); err == nil {
+				return "radkfile", nil
+			}
+		}
 	}
 
 	return "", errors.New("unrecognized dictionary format")
 }
 
-func ExportDb(inputPath, outputPath, format, language, title string, stride int, pretty bool) error {
-	handlers := map[string]func(string, string, string, string, int, bool) error{
-		"edict":     jmdictExportDb,
-		"forms":     formsExportDb,
-		"enamdict":  jmnedictExportDb,
-		"epwing":    epwingExportDb,
-		"kanjidic":  kanjidicExportDb,
-		"rikai":     rikaiExportDb,
-		"kanjifreq": frequencyKanjiExportDb,
-		"termfreq":  frequencyTermsExportDb,
+func ExportDb(inputPath, outputPath, format, language, title string, stride int, pretty bool, options ExportOptions) error {
+	handlers := map[string]func(string, string, string, string, int, bool, ExportOptions) error{
+		"edict":               jmdictExportDb,
+		"edict_batch":         jmdictBatchExportDb,
+		"forms":               formsExportDb,
+		"enamdict":            jmnedictExportDb,
+		"enamdict_components": jmnedictComponentsExportDb,
+		"epwing":              epwingExportDb,
+		"kanjidic":            kanjidicExportDb,
+		"kanji_vocab":         kanjiVocabExportDb,
+		"kanjivg":             kanjivgExportDb,
+		"radkfile":            radkfileExportDb,
+		"rikai":               rikaiExportDb,
+		"kanjifreq":           frequencyKanjiExportDb,
+		"termfreq":            frequencyTermsExportDb,
 	}
 
 	var err error
@@ -351,5 +477,16 @@ func ExportDb(inputPath, outputPath, format, language, title string, stride int,
 		return errors.New("unrecognized dictionary format")
 	}
 
-	return handler(inputPath, outputPath, strings.ToLower(language), title, stride, pretty)
+	options.RuleSet = strings.ToLower(options.RuleSet)
+	if !isValidRuleSet(options.RuleSet) {
+		return errors.New("unrecognized rule set: " + options.RuleSet)
+	}
+
+	profile, err := loadScoringProfile(options.ScoringPath)
+	if err != nil {
+		return err
+	}
+	options.scoring = &profile
+
+	return handler(inputPath, outputPath, strings.ToLower(language), title, stride, pretty, options)
 }
@@ -1,116 +1,304 @@
 package yomitan
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 
 	zig "github.com/themoeway/zero-epwing-go"
+	"golang.org/x/exp/slices"
 )
 
 type epwingExtractor interface {
 	extractTerms(entry zig.BookEntry, sequence int) []dbTerm
 	extractKanji(entry zig.BookEntry) []dbKanji
-	getFontNarrow() map[int]string
-	getFontWide() map[int]string
+	extractPitch(entry zig.BookEntry, terms []dbTerm) []dbMeta
+	getTags() []dbTag
+	getReferenceExps() []*regexp.Regexp
 	getRevision() string
 }
 
-func epwingExportDb(inputPath, outputPath, language, title string, stride int, pretty bool) error {
+func epwingExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	translateExp := regexp.MustCompile(`{{([nw])_(\d+)}}`)
+	epwingExtractors := map[string]epwingExtractor{
+		"大辞泉":            makeDaijisenExtractor(!options.PlainDefinitions),
+		"明鏡国語辞典":         makeMeikyouExtractor(!options.PlainDefinitions),
+		"故事ことわざの辞典":      makeKotowazaExtractor(!options.PlainDefinitions),
+		"研究社　新和英大辞典　第５版": makeWadaiExtractor(),
+		"小学館２":           makeShougakukan2Extractor(),
+	}
+
+	gaijiTables, err := loadEpwingData(options.GaijiPath, options.ExtractorPath, epwingExtractors, !options.PlainDefinitions)
+	if err != nil {
+		return err
+	}
+
 	book, err := zig.Load(inputPath)
 	if err != nil {
 		return err
 	}
 
-	translateExp := regexp.MustCompile(`{{([nw])_(\d+)}}`)
-	epwingExtractors := map[string]epwingExtractor{
-		"三省堂　スーパー大辞林":    makeDaijirinExtractor(),
-		"大辞泉":            makeDaijisenExtractor(),
-		"明鏡国語辞典":         makeMeikyouExtractor(),
-		"故事ことわざの辞典":      makeKotowazaExtractor(),
-		"研究社　新和英大辞典　第５版": makeWadaiExtractor(),
-		"広辞苑第六版":         makeKoujienExtractor(),
-		"付属資料":           makeKoujienExtractor(),
-		"学研国語大辞典":        makeGakkenExtractor(),
-		"古語辞典":           makeGakkenExtractor(),
-		"故事ことわざ辞典":       makeGakkenExtractor(),
-		"学研漢和大字典":        makeGakkenExtractor(),
-		"小学館２":           makeShougakukan2Extractor(),
+	if err := epwingCheckPitchMode(options.Pitch); err != nil {
+		return err
+	}
+
+	if options.ListSubbooks {
+		epwingListSubbooks(book, epwingExtractors)
+		return nil
+	}
+
+	selected, err := epwingSelectSubbooks(book, options.Subbooks)
+	if err != nil {
+		return err
 	}
 
 	var (
-		terms     dbTermList
-		kanji     dbKanjiList
-		revisions []string
-		titles    []string
-		sequence  int
+		subbooks []epwingSubbookData
+		sequence int
 	)
 
-	for _, subbook := range book.Subbooks {
-		if extractor, ok := epwingExtractors[subbook.Title]; ok {
-			fontNarrow := extractor.getFontNarrow()
-			fontWide := extractor.getFontWide()
-
-			translate := func(str string) string {
-				for _, matches := range translateExp.FindAllStringSubmatch(str, -1) {
-					var font map[int]string
-					if matches[1] == "n" {
-						font = fontNarrow
-					} else {
-						font = fontWide
-					}
+	gaijiReport := &epwingGaijiReport{}
+	referenceReport := epwingReferenceReport{}
+	gaijiImages := newEpwingGaijiImages(options.GaijiImagePath)
+
+	for _, subbookIndex := range selected {
+		subbook := book.Subbooks[subbookIndex]
+		extractor, ok := epwingExtractors[subbook.Title]
+		if !ok {
+			if options.SkipUnknownSubbooks {
+				fmt.Printf("Skipping subbook without a compatible extractor: '%s'\n", subbook.Title)
+				continue
+			}
+			fmt.Printf("No compatible extractor for '%s', using the generic extractor\n", subbook.Title)
+			extractor = makeGenericExtractor()
+		}
 
-					code, _ := strconv.Atoi(matches[2])
-					replacement, ok := font[code]
-					if !ok {
-						replacement = "�"
+		// Each archive only holds the images of its own subbook.
+		if options.SplitSubbooks {
+			gaijiImages = newEpwingGaijiImages(options.GaijiImagePath)
+		}
+
+		gaiji := gaijiTables.get(subbook.Title)
+		data := epwingSubbookData{
+			index:    subbookIndex,
+			title:    subbook.Title,
+			revision: extractor.getRevision(),
+			tags:     extractor.getTags(),
+			images:   gaijiImages,
+		}
+
+		// Codes without a replacement are kept in definitions
+		// if there is an image for them, see renderGlossary.
+		translate := func(str string, heading string, order int, keepImages bool) string {
+			for _, matches := range translateExp.FindAllStringSubmatch(str, -1) {
+				var font map[int]string
+				if matches[1] == "n" {
+					font = gaiji.narrow
+				} else {
+					font = gaiji.wide
+				}
+
+				code, _ := strconv.Atoi(matches[2])
+				replacement, ok := font[code]
+				if !ok && keepImages {
+					if _, ok := gaijiImages.find(subbook.Title, matches[1], code); ok {
+						continue
 					}
+				}
+				if !ok {
+					replacement = "�"
+					gaijiReport.add(subbook.Title, matches[1], code, heading, order)
+				}
+
+				str = strings.Replace(str, matches[0], replacement, -1)
+			}
 
-					str = strings.Replace(str, matches[0], replacement, -1)
+			return newlineExp.ReplaceAllLiteralString(str, "\n")
+		}
+
+		extractEntry := func(index int) epwingEntryResult {
+			var result epwingEntryResult
+			entry := subbook.Entries[index]
+			entrySequence := sequence + index
+			entry.Heading = translate(entry.Heading, entry.Heading, entrySequence, false)
+			entry.Text = translate(entry.Text, entry.Heading, entrySequence, true)
+
+			newTerms := extractor.extractTerms(entry, entrySequence)
+			if options.RuleSet == extendedRuleSet {
+				for i, term := range newTerms {
+					newTerms[i].Rules = extendedTermRules(term.Expression, term.Rules)
 				}
-				pattern := regexp.MustCompile("\n+")
-				str = pattern.ReplaceAllLiteralString(str, "\n")
+			}
+			for i := range newTerms {
+				gaijiImages.renderGlossary(&newTerms[i], subbook.Title, translateExp)
+			}
 
-				return str
+			result.terms = newTerms
+			result.kanji = extractor.extractKanji(entry)
+			if options.Pitch != "" {
+				result.pitch = extractor.extractPitch(entry, newTerms)
 			}
+			return result
+		}
+
+		for _, result := range epwingExtractEntries(len(subbook.Entries), extractEntry) {
+			data.terms = append(data.terms, result.terms...)
+			data.kanji = append(data.kanji, result.kanji...)
+			data.pitch = append(data.pitch, result.pitch...)
+		}
+		sequence += len(subbook.Entries)
 
-			for _, entry := range subbook.Entries {
-				entry.Heading = translate(entry.Heading)
-				entry.Text = translate(entry.Text)
+		epwingLinkReferences(data.terms, subbook.Title, extractor.getReferenceExps(), referenceReport)
 
-				terms = append(terms, extractor.extractTerms(entry, sequence)...)
-				kanji = append(kanji, extractor.extractKanji(entry)...)
+		subbooks = append(subbooks, data)
+	}
+
+	gaijiReport.print()
+	referenceReport.print()
 
-				sequence++
+	if len(subbooks) == 0 {
+		return errors.New("no subbooks to convert")
+	}
+
+	if options.SplitSubbooks {
+		if err := os.MkdirAll(outputPath, 0755); err != nil {
+			return err
+		}
+		for _, data := range subbooks {
+			subbookTitle := data.title
+			if title != "" {
+				subbookTitle = title + " (" + data.title + ")"
+			}
+			subbookPath := filepath.Join(outputPath, epwingSubbookFileName(data.index, data.title))
+			if err := epwingWriteDb(subbookPath, subbookTitle, data, options.Pitch, stride, pretty); err != nil {
+				return err
 			}
+		}
+		return nil
+	}
 
-			revisions = append(revisions, extractor.getRevision())
-			titles = append(titles, subbook.Title)
-		} else {
-			return fmt.Errorf("failed to find compatible extractor for '%s'", subbook.Title)
+	var (
+		revisions []string
+		titles    []string
+	)
+	merged := epwingSubbookData{images: gaijiImages}
+	for _, data := range subbooks {
+		merged.terms = append(merged.terms, data.terms...)
+		merged.kanji = append(merged.kanji, data.kanji...)
+		for _, tag := range data.tags {
+			if !slices.ContainsFunc(merged.tags, func(t dbTag) bool { return t.Name == tag.Name }) {
+				merged.tags = append(merged.tags, tag)
+			}
 		}
+		merged.pitch = append(merged.pitch, data.pitch...)
+		revisions = append(revisions, data.revision)
+		titles = append(titles, data.title)
 	}
+	merged.revision = strings.Join(revisions, ";")
 
 	if title == "" {
 		title = strings.Join(titles, ", ")
 	}
 
+	return epwingWriteDb(outputPath, title, merged, options.Pitch, stride, pretty)
+}
+
+var newlineExp = regexp.MustCompile("\n+")
+
+type epwingEntryResult struct {
+	terms []dbTerm
+	kanji []dbKanji
+	pitch []dbMeta
+}
+
+// Entries are extracted in parallel; their results are collected in book
+// order so that the output and the sequence numbers do not depend on
+// scheduling.
+func epwingExtractEntries(count int, extractEntry func(index int) epwingEntryResult) []epwingEntryResult {
+	results := make([]epwingEntryResult, count)
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i] = extractEntry(i)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return results
+}
+
+type epwingSubbookData struct {
+	index    int
+	title    string
+	revision string
+	terms    dbTermList
+	kanji    dbKanjiList
+	tags     dbTagList
+	pitch    dbMetaList
+	images   *epwingGaijiImages
+}
+
+// Pitch records are either written to the term_meta bank of the
+// dictionary or to a companion pitch dictionary next to it.
+func epwingWriteDb(outputPath, title string, data epwingSubbookData, pitchMode string, stride int, pretty bool) error {
 	recordData := map[string]dbRecordList{
-		"kanji": kanji.crush(),
-		"term":  terms.crush(),
+		"kanji": data.kanji.crush(),
+		"tag":   data.tags.crush(),
+		"term":  data.terms.crush(),
+	}
+	if pitchMode == epwingPitchInclude {
+		recordData["term_meta"] = data.pitch.crush()
 	}
 
 	index := dbIndex{
 		Title:     title,
-		Revision:  strings.Join(revisions, ";"),
+		Revision:  data.revision,
 		Sequenced: true,
 	}
 
-	return writeDb(
+	err := writeDbMedia(
 		outputPath,
 		index,
 		recordData,
+		data.images.getMedia(),
+		stride,
+		pretty,
+	)
+	if err != nil || pitchMode != epwingPitchCompanion {
+		return err
+	}
+
+	if len(data.pitch) == 0 {
+		fmt.Printf("No pitch accent data found for '%s'\n", title)
+		return nil
+	}
+
+	pitchIndex := dbIndex{
+		Title:    title + " (Pitch)",
+		Revision: data.revision,
+	}
+	pitchData := map[string]dbRecordList{
+		"term_meta": data.pitch.crush(),
+	}
+
+	return writeDb(
+		epwingPitchCompanionPath(outputPath),
+		pitchIndex,
+		pitchData,
 		stride,
 		pretty,
 	)
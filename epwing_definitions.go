@@ -0,0 +1,675 @@
+package yomitan
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	zig "github.com/themoeway/zero-epwing-go"
+	"golang.org/x/text/width"
+)
+
+// Extractor definitions describe how to read the entries of monolingual
+// EPWING books which follow the common "よみ【表記】" heading layout, so
+// that supporting a new book does not require writing Go code:
+//
+//	{
+//		"subbooks": ["広辞苑第六版"],
+//		"revision": "koujien",
+//		"heading": "(?P<reading>[^（【〖]+)(?:【(?P<expression>.*)】)?",
+//		"readingStrip": "[‐・]+",
+//		"expressionSplit": "・",
+//		"expressionVariant": "\\(([^\\)]*)\\)",
+//		"meta": "（([^）]*)）",
+//		"rules": [{"tag": "形", "rules": ["adj-i"]}],
+//		"replacements": [["(1)", "①"]],
+//		"structured": true,
+//		"references": ["⇒(?P<ref>[^。]+)"],
+//		"pitch": "［([０-９]+)］",
+//		"kanji": {
+//			"heading": "^(?P<character>\\p{Han})$",
+//			"onyomi": "音：([^\\n]+)",
+//			"kunyomi": "訓：([^\\n]+)",
+//			"readingSplit": "[、・]",
+//			"meanings": "意味：([^\\n]+)",
+//			"stats": [{"name": "strokes", "category": "misc", "notes": "Stroke count", "pattern": "総画数：(\\d+)"}]
+//		},
+//		"wide": {"B021": "嗩"}
+//	}
+//
+// The heading expression captures the reading and expressions of an
+// entry in the groups named "reading" and "expression". Characters
+// matching readingStrip are removed from readings, expressions are split
+// with expressionSplit, and optional parts matching expressionVariant
+// produce an expression with and without them. The first group of meta
+// holds the grammatical tags of the text (split on "・"), which are
+// mapped to deinflection rules by the first matching rule. Replacements
+// are applied to the text before anything else. Structured definitions
+// are rendered with epwingDefinitionContent. References list the
+// cross-reference expressions of the book (see epwing_references.go),
+// which default to the common ⇒ and → forms if the list is missing. The
+// first group of pitch holds the accent numbers of a heading, separated
+// by "・" or ",", and its matches are removed before parsing. The
+// narrow and wide gaiji tables have the same form as the files in the
+// gaiji directory.
+//
+// Character dictionaries also describe their kanji entries, whose
+// heading matches the kanji heading expression with the character in
+// the group named "character". The first group of the other expressions
+// holds the readings, meanings (split on sense markers) or stats of the
+// character, and each stat is exported with its own tag.
+//
+// A definition which extends another one, named by its revision, only
+// needs to list its subbooks and the fields which differ:
+//
+//	{"subbooks": ["学研漢和大字典"], "revision": "gakken_kanwa", "extends": "gakken", "kanji": {...}}
+//
+// A definition without a heading expression which lists references
+// only replaces the cross-reference expressions of the extractors of its
+// subbooks, including the built-in ones written in Go (which use the
+// default expressions, or none for bilingual books):
+//
+//	{"subbooks": ["大辞泉"], "references": ["⇒(?P<ref>[^。]+)"]}
+//
+// The definitions of the supported books are embedded from the
+// extractors directory; user definitions listed in the ExtractorPath
+// option replace the extractors of the subbooks they list.
+//
+//go:embed extractors/*.json
+var epwingDefinitionFiles embed.FS
+
+type epwingRuleDefinition struct {
+	Tag                string   `json:"tag"`
+	TagPattern         string   `json:"tagPattern"`
+	Expression         string   `json:"expression"`
+	ExpressionSuffixes []string `json:"expressionSuffixes"`
+	Rules              []string `json:"rules"`
+}
+
+type epwingStatDefinition struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Notes    string `json:"notes"`
+	Pattern  string `json:"pattern"`
+}
+
+type epwingKanjiDefinition struct {
+	Heading      string                 `json:"heading"`
+	Onyomi       string                 `json:"onyomi"`
+	Kunyomi      string                 `json:"kunyomi"`
+	ReadingSplit string                 `json:"readingSplit"`
+	Meanings     string                 `json:"meanings"`
+	Stats        []epwingStatDefinition `json:"stats"`
+}
+
+type epwingDefinition struct {
+	epwingGaijiFile
+	Revision          string                 `json:"revision"`
+	Extends           string                 `json:"extends"`
+	Heading           string                 `json:"heading"`
+	ReadingStrip      string                 `json:"readingStrip"`
+	ExpressionSplit   string                 `json:"expressionSplit"`
+	ExpressionVariant string                 `json:"expressionVariant"`
+	Meta              string                 `json:"meta"`
+	Rules             []epwingRuleDefinition `json:"rules"`
+	Replacements      [][2]string            `json:"replacements"`
+	Structured        bool                   `json:"structured"`
+	References        []string               `json:"references"`
+	Pitch             string                 `json:"pitch"`
+	Kanji             *epwingKanjiDefinition `json:"kanji"`
+}
+
+type epwingDataFile struct {
+	data   []byte
+	source string
+}
+
+// Reads the JSON files embedded in dir and those of the user path, which
+// is either a single JSON file or a directory of them.
+func readEpwingDataFiles(files embed.FS, dir, userPath string) (builtin, user []epwingDataFile, err error) {
+	entries, err := files.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, entry := range entries {
+		data, err := files.ReadFile(dir + "/" + entry.Name())
+		if err != nil {
+			return nil, nil, err
+		}
+		builtin = append(builtin, epwingDataFile{data, entry.Name()})
+	}
+
+	if userPath == "" {
+		return builtin, nil, nil
+	}
+
+	userPaths := []string{userPath}
+	if info, err := os.Stat(userPath); err != nil {
+		return nil, nil, err
+	} else if info.IsDir() {
+		if userPaths, err = filepath.Glob(filepath.Join(userPath, "*.json")); err != nil {
+			return nil, nil, err
+		}
+		sort.Strings(userPaths)
+	}
+	for _, path := range userPaths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		user = append(user, epwingDataFile{data, path})
+	}
+
+	return builtin, user, nil
+}
+
+// Loads the gaiji tables and the extractor definitions. The gaiji tables
+// of definitions are applied after the built-in gaiji tables but before
+// the user ones, so that the latter can still override them. Definitions
+// are only rendered as structured content if structured is set. The
+// extractors of the definitions are added to the given extractors.
+func loadEpwingData(gaijiPath, definitionPath string, extractors map[string]epwingExtractor, structured bool) (epwingGaijiTables, error) {
+	gaijiFiles, userGaijiFiles, err := readEpwingDataFiles(epwingGaijiFiles, "gaiji", gaijiPath)
+	if err != nil {
+		return nil, err
+	}
+	definitionFiles, userDefinitionFiles, err := readEpwingDataFiles(epwingDefinitionFiles, "extractors", definitionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := epwingGaijiTables{}
+	for _, file := range gaijiFiles {
+		if err := tables.load(file.data, file.source); err != nil {
+			return nil, err
+		}
+	}
+
+	definitionFiles = append(definitionFiles, userDefinitionFiles...)
+	revisionFiles := map[string]epwingDataFile{}
+	for _, file := range definitionFiles {
+		var definition epwingDefinition
+		if err := json.Unmarshal(file.data, &definition); err != nil {
+			return nil, fmt.Errorf("failed to parse extractor definition %s: %w", file.source, err)
+		}
+		if definition.Revision != "" {
+			revisionFiles[definition.Revision] = file
+		}
+	}
+
+	for _, file := range definitionFiles {
+		definition, err := parseEpwingDefinition(file, revisionFiles)
+		if err != nil {
+			return nil, err
+		}
+		if err := tables.load(file.data, file.source); err != nil {
+			return nil, err
+		}
+		if definition.Heading == "" && definition.References != nil {
+			if err := overrideReferenceExps(extractors, definition, file.source); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		extractor, err := makeDefinitionExtractor(definition, file.source, structured)
+		if err != nil {
+			return nil, err
+		}
+		for _, subbook := range definition.Subbooks {
+			extractors[subbook] = extractor
+		}
+	}
+
+	for _, file := range userGaijiFiles {
+		if err := tables.load(file.data, file.source); err != nil {
+			return nil, err
+		}
+	}
+
+	return tables, nil
+}
+
+// Parses a definition on top of the definitions it extends. Only the
+// gaiji tables of the file itself are loaded, see loadEpwingData.
+func parseEpwingDefinition(file epwingDataFile, revisionFiles map[string]epwingDataFile) (epwingDefinition, error) {
+	var definition epwingDefinition
+	if err := json.Unmarshal(file.data, &definition); err != nil {
+		return definition, fmt.Errorf("failed to parse extractor definition %s: %w", file.source, err)
+	}
+
+	files := []epwingDataFile{file}
+	seen := map[string]bool{definition.Revision: true}
+	for base := definition.Extends; base != ""; {
+		if seen[base] {
+			return definition, fmt.Errorf("extractor definition %s extends itself through '%s'", file.source, base)
+		}
+		seen[base] = true
+		baseFile, ok := revisionFiles[base]
+		if !ok {
+			return definition, fmt.Errorf("extractor definition %s extends unknown revision '%s'", file.source, base)
+		}
+		files = append(files, baseFile)
+		var baseDefinition epwingDefinition
+		if err := json.Unmarshal(baseFile.data, &baseDefinition); err != nil {
+			return definition, fmt.Errorf("failed to parse extractor definition %s: %w", baseFile.source, err)
+		}
+		base = baseDefinition.Extends
+	}
+
+	definition = epwingDefinition{}
+	for i := len(files) - 1; i >= 0; i-- {
+		if err := json.Unmarshal(files[i].data, &definition); err != nil {
+			return definition, fmt.Errorf("failed to parse extractor definition %s: %w", files[i].source, err)
+		}
+	}
+	return definition, nil
+}
+
+func compileReferenceExps(definition epwingDefinition, source string) ([]*regexp.Regexp, error) {
+	exps := []*regexp.Regexp{}
+	for _, reference := range definition.References {
+		exp, err := regexp.Compile(reference)
+		if err != nil {
+			return nil, fmt.Errorf("invalid references expression in extractor definition %s: %w", source, err)
+		}
+		exps = append(exps, exp)
+	}
+	return exps, nil
+}
+
+func overrideReferenceExps(extractors map[string]epwingExtractor, definition epwingDefinition, source string) error {
+	if len(definition.Subbooks) == 0 {
+		return fmt.Errorf("extractor definition %s does not list any subbooks", source)
+	}
+	exps, err := compileReferenceExps(definition, source)
+	if err != nil {
+		return err
+	}
+	for _, subbook := range definition.Subbooks {
+		extractor, ok := extractors[subbook]
+		if !ok {
+			return fmt.Errorf("extractor definition %s overrides the references of subbook '%s' without an extractor", source, subbook)
+		}
+		if override, ok := extractor.(*epwingReferenceOverride); ok {
+			extractor = override.epwingExtractor
+		}
+		extractors[subbook] = &epwingReferenceOverride{extractor, exps}
+	}
+	return nil
+}
+
+type definitionRule struct {
+	tag                string
+	tagExp             *regexp.Regexp
+	expression         string
+	expressionSuffixes []string
+	rules              []string
+}
+
+func (rule *definitionRule) matches(term *dbTerm, tag string) bool {
+	if rule.tag != "" && rule.tag != tag {
+		return false
+	}
+	if rule.tagExp != nil && !rule.tagExp.MatchString(tag) {
+		return false
+	}
+	if rule.expression != "" && rule.expression != term.Expression {
+		return false
+	}
+	if len(rule.expressionSuffixes) > 0 {
+		for _, suffix := range rule.expressionSuffixes {
+			if strings.HasSuffix(term.Expression, suffix) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+type definitionExtractor struct {
+	revision      string
+	partsExp      *regexp.Regexp
+	readingIndex  int
+	expIndex      int
+	readGroupExp  *regexp.Regexp
+	expSplitExp   *regexp.Regexp
+	expVarExp     *regexp.Regexp
+	metaExp       *regexp.Regexp
+	rules         []definitionRule
+	cosmetics     *strings.Replacer
+	structured    bool
+	referenceExps []*regexp.Regexp
+	pitchExp      *regexp.Regexp
+	kanji         *definitionKanji
+}
+
+type definitionStat struct {
+	tag dbTag
+	exp *regexp.Regexp
+}
+
+type definitionKanji struct {
+	headingExp   *regexp.Regexp
+	onyomiExp    *regexp.Regexp
+	kunyomiExp   *regexp.Regexp
+	readSplitExp *regexp.Regexp
+	meaningsExp  *regexp.Regexp
+	stats        []definitionStat
+}
+
+func makeDefinitionExtractor(definition epwingDefinition, source string, structured bool) (epwingExtractor, error) {
+	var err error
+	compile := func(name, expr string) *regexp.Regexp {
+		if err != nil || expr == "" {
+			return nil
+		}
+		var exp *regexp.Regexp
+		if exp, err = regexp.Compile(expr); err != nil {
+			err = fmt.Errorf("invalid %s expression in extractor definition %s: %w", name, source, err)
+		}
+		return exp
+	}
+
+	e := &definitionExtractor{
+		revision:     definition.Revision,
+		partsExp:     compile("heading", definition.Heading),
+		readGroupExp: compile("readingStrip", definition.ReadingStrip),
+		expSplitExp:  compile("expressionSplit", definition.ExpressionSplit),
+		expVarExp:    compile("expressionVariant", definition.ExpressionVariant),
+		metaExp:      compile("meta", definition.Meta),
+		structured:   definition.Structured && structured,
+		pitchExp:     compile("pitch", definition.Pitch),
+	}
+	if definition.References == nil {
+		e.referenceExps = epwingDefaultReferenceExps
+	}
+	for _, reference := range definition.References {
+		e.referenceExps = append(e.referenceExps, compile("references", reference))
+	}
+	for _, rule := range definition.Rules {
+		e.rules = append(e.rules, definitionRule{
+			tag:                rule.Tag,
+			tagExp:             compile("tagPattern", rule.TagPattern),
+			expression:         rule.Expression,
+			expressionSuffixes: rule.ExpressionSuffixes,
+			rules:              rule.Rules,
+		})
+	}
+	if kanji := definition.Kanji; kanji != nil {
+		e.kanji = &definitionKanji{
+			headingExp:   compile("kanji heading", kanji.Heading),
+			onyomiExp:    compile("onyomi", kanji.Onyomi),
+			kunyomiExp:   compile("kunyomi", kanji.Kunyomi),
+			readSplitExp: compile("readingSplit", kanji.ReadingSplit),
+			meaningsExp:  compile("meanings", kanji.Meanings),
+		}
+		for _, stat := range kanji.Stats {
+			e.kanji.stats = append(e.kanji.stats, definitionStat{
+				tag: dbTag{Name: stat.Name, Category: stat.Category, Notes: stat.Notes},
+				exp: compile("stat", stat.Pattern),
+			})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(definition.Subbooks) == 0 {
+		return nil, fmt.Errorf("extractor definition %s does not list any subbooks", source)
+	}
+	if e.partsExp == nil {
+		return nil, fmt.Errorf("extractor definition %s has no heading expression", source)
+	}
+	e.readingIndex = e.partsExp.SubexpIndex("reading")
+	e.expIndex = e.partsExp.SubexpIndex("expression")
+	if e.readingIndex == -1 && e.expIndex == -1 {
+		return nil, fmt.Errorf("heading expression in extractor definition %s has no reading or expression group", source)
+	}
+	if e.kanji != nil && (e.kanji.headingExp == nil || e.kanji.headingExp.SubexpIndex("character") == -1) {
+		return nil, fmt.Errorf("kanji heading expression in extractor definition %s has no character group", source)
+	}
+	if e.metaExp != nil && e.metaExp.NumSubexp() == 0 {
+		return nil, fmt.Errorf("meta expression in extractor definition %s has no group", source)
+	}
+
+	if len(definition.Replacements) > 0 {
+		var oldnew []string
+		for _, replacement := range definition.Replacements {
+			oldnew = append(oldnew, replacement[0], replacement[1])
+		}
+		e.cosmetics = strings.NewReplacer(oldnew...)
+	}
+
+	return e, nil
+}
+
+func (e *definitionExtractor) group(matches []string, index int) string {
+	if index == -1 {
+		return ""
+	}
+	return matches[index]
+}
+
+func (e *definitionExtractor) extractTerms(entry zig.BookEntry, sequence int) []dbTerm {
+	heading := entry.Heading
+	if e.pitchExp != nil {
+		heading = e.pitchExp.ReplaceAllLiteralString(heading, "")
+	}
+	matches := e.partsExp.FindStringSubmatch(heading)
+	if matches == nil {
+		return nil
+	}
+
+	var expressions, readings []string
+	if expression := e.group(matches, e.expIndex); len(expression) > 0 {
+		if e.metaExp != nil {
+			expression = e.metaExp.ReplaceAllLiteralString(expression, "")
+		}
+		splits := []string{expression}
+		if e.expSplitExp != nil {
+			splits = e.expSplitExp.Split(expression, -1)
+		}
+		for _, split := range splits {
+			if e.expVarExp == nil {
+				expressions = append(expressions, split)
+				continue
+			}
+			splitInc := e.expVarExp.ReplaceAllString(split, "$1")
+			expressions = append(expressions, splitInc)
+			if split != splitInc {
+				splitExc := e.expVarExp.ReplaceAllLiteralString(split, "")
+				expressions = append(expressions, splitExc)
+			}
+		}
+	}
+
+	if reading := e.group(matches, e.readingIndex); len(reading) > 0 {
+		if e.readGroupExp != nil {
+			reading = e.readGroupExp.ReplaceAllLiteralString(reading, "")
+		}
+		readings = append(readings, reading)
+	}
+
+	entryText := entry.Text
+	if e.cosmetics != nil {
+		entryText = e.cosmetics.Replace(entryText)
+	}
+
+	var glossary any = entryText
+	if e.structured {
+		glossary = epwingDefinitionContent(entryText, e.metaExp)
+	}
+
+	var tags []string
+	if e.metaExp != nil {
+		for _, split := range strings.Split(entryText, "\n") {
+			if matches := e.metaExp.FindStringSubmatch(split); matches != nil {
+				tags = append(tags, strings.Split(matches[1], "・")...)
+			}
+		}
+	}
+
+	var terms []dbTerm
+	if len(expressions) == 0 {
+		for _, reading := range readings {
+			term := dbTerm{
+				Expression: reading,
+				Glossary:   []any{glossary},
+				Sequence:   sequence,
+			}
+
+			e.exportRules(&term, tags)
+			terms = append(terms, term)
+		}
+
+	} else {
+		if len(readings) == 0 {
+			readings = append(readings, "")
+		}
+		for _, expression := range expressions {
+			for _, reading := range readings {
+				term := dbTerm{
+					Expression: expression,
+					Reading:    reading,
+					Glossary:   []any{glossary},
+					Sequence:   sequence,
+				}
+
+				e.exportRules(&term, tags)
+				terms = append(terms, term)
+			}
+		}
+	}
+
+	return terms
+}
+
+func (e *definitionExtractor) extractKanji(entry zig.BookEntry) []dbKanji {
+	if e.kanji == nil {
+		return nil
+	}
+	matches := e.kanji.headingExp.FindStringSubmatch(entry.Heading)
+	if matches == nil {
+		return nil
+	}
+
+	entryText := entry.Text
+	if e.cosmetics != nil {
+		entryText = e.cosmetics.Replace(entryText)
+	}
+
+	kanji := dbKanji{
+		Character: matches[e.kanji.headingExp.SubexpIndex("character")],
+		Onyomi:    e.kanji.readings(entryText, e.kanji.onyomiExp),
+		Kunyomi:   e.kanji.readings(entryText, e.kanji.kunyomiExp),
+		Meanings:  e.kanji.meanings(entryText),
+		Stats:     map[string]string{},
+	}
+	for _, stat := range e.kanji.stats {
+		if value := definitionFirstGroup(entryText, stat.exp); value != "" {
+			kanji.Stats[stat.tag.Name] = width.Narrow.String(value)
+		}
+	}
+
+	return []dbKanji{kanji}
+}
+
+func definitionFirstGroup(text string, exp *regexp.Regexp) string {
+	if exp == nil {
+		return ""
+	}
+	if matches := exp.FindStringSubmatch(text); len(matches) > 1 {
+		return strings.TrimSpace(matches[1])
+	}
+	return ""
+}
+
+func (k *definitionKanji) readings(text string, exp *regexp.Regexp) []string {
+	var readings []string
+	value := definitionFirstGroup(text, exp)
+	if value == "" {
+		return nil
+	}
+	splits := []string{value}
+	if k.readSplitExp != nil {
+		splits = k.readSplitExp.Split(value, -1)
+	}
+	for _, reading := range splits {
+		if reading = strings.TrimSpace(reading); reading != "" {
+			readings = append(readings, reading)
+		}
+	}
+	return readings
+}
+
+// Meanings are taken from the meanings expression, or from the numbered
+// senses of the text if the book has no such field.
+func (k *definitionKanji) meanings(text string) []string {
+	if value := definitionFirstGroup(text, k.meaningsExp); value != "" {
+		text = value
+	} else if k.meaningsExp != nil {
+		return nil
+	}
+
+	// Meaning fields are short and hold no references, so every
+	// marker starts a meaning.
+	senseLocs := epwingSenseLocs(text, nil)
+	if len(senseLocs) == 0 {
+		if text = strings.TrimSpace(text); text != "" {
+			return []string{text}
+		}
+		return nil
+	}
+
+	var meanings []string
+	for i, loc := range senseLocs {
+		end := len(text)
+		if i+1 < len(senseLocs) {
+			end = senseLocs[i+1][0]
+		}
+		if meaning := strings.TrimSpace(text[loc[1]:end]); meaning != "" {
+			meanings = append(meanings, meaning)
+		}
+	}
+	return meanings
+}
+
+func (e *definitionExtractor) getTags() []dbTag {
+	if e.kanji == nil {
+		return nil
+	}
+	var tags []dbTag
+	for _, stat := range e.kanji.stats {
+		tags = append(tags, stat.tag)
+	}
+	return tags
+}
+
+func (e *definitionExtractor) extractPitch(entry zig.BookEntry, terms []dbTerm) []dbMeta {
+	return epwingPitchMeta(terms, epwingPitchPositions(entry.Heading, e.pitchExp))
+}
+
+func (e *definitionExtractor) exportRules(term *dbTerm, tags []string) {
+	for _, tag := range tags {
+		for _, rule := range e.rules {
+			if rule.matches(term, tag) {
+				term.addRules(rule.rules...)
+				break
+			}
+		}
+	}
+}
+
+func (e *definitionExtractor) getReferenceExps() []*regexp.Regexp {
+	return e.referenceExps
+}
+
+func (e *definitionExtractor) getRevision() string {
+	return e.revision
+}
@@ -0,0 +1,254 @@
+package yomitan
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	zig "github.com/themoeway/zero-epwing-go"
+)
+
+func TestDefinitionExtractKanji(t *testing.T) {
+	extractors := map[string]epwingExtractor{}
+	if _, err := loadEpwingData("", "", extractors, true); err != nil {
+		t.Fatal(err)
+	}
+	extractor := extractors["学研漢和大字典"]
+	if extractor == nil {
+		t.Fatal("no extractor for 学研漢和大字典")
+	}
+	if revision := extractor.getRevision(); revision != "gakken_kanwa" {
+		t.Errorf("revision = %q, want %q", revision, "gakken_kanwa")
+	}
+
+	tests := []struct {
+		name  string
+		entry zig.BookEntry
+		want  []dbKanji
+	}{
+		{
+			name: "labeled fields",
+			entry: zig.BookEntry{
+				Heading: "【愛】",
+				Text:    "愛\n総画数：１３画\n部首：心\n親字番号：２３４５\nJIS：16-06\n音　アイ\n訓　いとしい、めでる\n意味　(1)かわいがる。(2)このむ。",
+			},
+			want: []dbKanji{{
+				Character: "愛",
+				Onyomi:    []string{"アイ"},
+				Kunyomi:   []string{"いとしい", "めでる"},
+				Meanings:  []string{"かわいがる。", "このむ。"},
+				Stats: map[string]string{
+					"strokes": "13",
+					"radical": "心",
+					"kanwa":   "2345",
+					"jis208":  "16-06",
+				},
+			}},
+		},
+		{
+			name: "bracketed labels",
+			entry: zig.BookEntry{
+				Heading: "あい【愛】",
+				Text:    "〔音〕アイ・オ\n〔訓〕めでる\n〔意味〕かわいがる。",
+			},
+			want: []dbKanji{{
+				Character: "愛",
+				Onyomi:    []string{"アイ", "オ"},
+				Kunyomi:   []string{"めでる"},
+				Meanings:  []string{"かわいがる。"},
+				Stats:     map[string]string{},
+			}},
+		},
+		{
+			name: "compound",
+			entry: zig.BookEntry{
+				Heading: "あいじょう【愛情】",
+				Text:    "〔意味〕かわいがる気持ち。",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := extractor.extractKanji(test.entry)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("extractKanji() = %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDefinitionExtends(t *testing.T) {
+	extractors := map[string]epwingExtractor{}
+	if _, err := loadEpwingData("", "", extractors, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// The kanji dictionary extends the definition of the other Gakken
+	// books, whose entries are not character entries.
+	base := extractors["学研国語大辞典"].(*definitionExtractor)
+	extended := extractors["学研漢和大字典"].(*definitionExtractor)
+	if base.kanji != nil {
+		t.Error("学研国語大辞典 has a kanji definition")
+	}
+	if extended.kanji == nil {
+		t.Error("学研漢和大字典 has no kanji definition")
+	}
+	if extended.partsExp.String() != base.partsExp.String() {
+		t.Errorf("heading = %q, want %q", extended.partsExp, base.partsExp)
+	}
+	if len(extended.rules) != len(base.rules) {
+		t.Errorf("%d rules, want %d", len(extended.rules), len(base.rules))
+	}
+}
+
+func TestMakeDefinitionExtractor(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		wantErr    bool
+	}{
+		{
+			name:       "minimal",
+			definition: `{"subbooks": ["辞典"], "heading": "(?P<reading>[^【]+)"}`,
+		},
+		{
+			name:       "kanji",
+			definition: `{"subbooks": ["辞典"], "heading": "(?P<expression>.+)", "kanji": {"heading": "^(?P<character>\\p{Han})$"}}`,
+		},
+		{
+			name:       "no subbooks",
+			definition: `{"heading": "(?P<reading>[^【]+)"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "no heading",
+			definition: `{"subbooks": ["辞典"], "meta": "（([^）]*)）"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "heading without groups",
+			definition: `{"subbooks": ["辞典"], "heading": "([^【]+)"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid expression",
+			definition: `{"subbooks": ["辞典"], "heading": "(?P<reading>[^【]+)", "readingStrip": "[‐・"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid rule expression",
+			definition: `{"subbooks": ["辞典"], "heading": "(?P<reading>.+)", "rules": [{"tagPattern": "(動", "rules": ["v5"]}]}`,
+			wantErr:    true,
+		},
+		{
+			name:       "meta without group",
+			definition: `{"subbooks": ["辞典"], "heading": "(?P<reading>.+)", "meta": "（[^）]*）"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "kanji heading without group",
+			definition: `{"subbooks": ["辞典"], "heading": "(?P<reading>.+)", "kanji": {"heading": "^\\p{Han}$"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown base",
+			definition: `{"subbooks": ["辞典"], "revision": "jiten", "extends": "unknown"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "extends itself",
+			definition: `{"subbooks": ["辞典"], "revision": "jiten", "extends": "jiten"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "references of unknown subbook",
+			definition: `{"subbooks": ["辞典"], "references": ["⇒(.+)"]}`,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid JSON",
+			definition: `{"subbooks": "辞典"}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "definition.json")
+			if err := os.WriteFile(path, []byte(test.definition), 0644); err != nil {
+				t.Fatal(err)
+			}
+			extractors := map[string]epwingExtractor{}
+			_, err := loadEpwingData("", path, extractors, true)
+			if test.wantErr {
+				if err == nil {
+					t.Error("loadEpwingData() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if extractors["辞典"] == nil {
+				t.Error("no extractor for the subbook of the definition")
+			}
+		})
+	}
+}
+
+func TestDefinitionExtractTerms(t *testing.T) {
+	definition := epwingDefinition{
+		epwingGaijiFile:   epwingGaijiFile{Subbooks: []string{"辞典"}},
+		Heading:           `(?P<reading>[^【]+)(?:【(?P<expression>.*)】)?`,
+		ReadingStrip:      `[‐・]+`,
+		ExpressionSplit:   `・`,
+		ExpressionVariant: `\(([^\)]*)\)`,
+		Meta:              `（([^）]*)）`,
+		Rules: []epwingRuleDefinition{
+			{Tag: "形", Rules: []string{"adj-i"}},
+			{TagPattern: `動.五`, Rules: []string{"v5"}},
+		},
+		Pitch: `［([０-９]+)］`,
+	}
+	extractor, err := makeDefinitionExtractor(definition, "test", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		entry zig.BookEntry
+		want  [][3]string
+	}{
+		{
+			name:  "reading and expressions",
+			entry: zig.BookEntry{Heading: "あ・う［１］【会う・合う】", Text: "（動ワ五）出会う。"},
+			want:  [][3]string{{"会う", "あう", "v5"}, {"合う", "あう", "v5"}},
+		},
+		{
+			name:  "optional part",
+			entry: zig.BookEntry{Heading: "あたらしい【新(し)い】", Text: "（形）新しい。"},
+			want:  [][3]string{{"新しい", "あたらしい", "adj-i"}, {"新い", "あたらしい", "adj-i"}},
+		},
+		{
+			name:  "reading only",
+			entry: zig.BookEntry{Heading: "あっ", Text: "（感）驚いたときの声。"},
+			want:  [][3]string{{"あっ", "", ""}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got [][3]string
+			for _, term := range extractor.extractTerms(test.entry, 0) {
+				got = append(got, [3]string{term.Expression, term.Reading, strings.Join(term.Rules, " ")})
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("extractTerms() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
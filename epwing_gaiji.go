@@ -0,0 +1,300 @@
+package yomitan
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+// Gaiji are the custom characters of an EPWING book, which appear in
+// the extracted text as {{n_41249}} (narrow font) or {{w_41249}} (wide
+// font). Their replacements are read from JSON files of the form
+//
+//	{
+//		"subbooks": ["大辞泉"],
+//		"narrow": {"A121": " "},
+//		"wide": {"B021": "嗩"}
+//	}
+//
+// where the keys are the hexadecimal character codes. The tables of the
+// supported books are embedded from the gaiji directory and from the
+// extractor definitions (see epwing_definitions.go); user files listed in
+// the GaijiPath option are applied on top of them, adding codes or
+// overriding existing replacements.
+//
+//go:embed gaiji/*.json
+var epwingGaijiFiles embed.FS
+
+type epwingGaijiFile struct {
+	Subbooks []string          `json:"subbooks"`
+	Narrow   map[string]string `json:"narrow"`
+	Wide     map[string]string `json:"wide"`
+}
+
+type epwingGaijiTable struct {
+	narrow map[int]string
+	wide   map[int]string
+}
+
+type epwingGaijiTables map[string]*epwingGaijiTable
+
+func (tables epwingGaijiTables) get(subbook string) *epwingGaijiTable {
+	table, ok := tables[subbook]
+	if !ok {
+		table = &epwingGaijiTable{narrow: map[int]string{}, wide: map[int]string{}}
+		tables[subbook] = table
+	}
+	return table
+}
+
+func parseGaijiCodes(codes map[string]string, font map[int]string, source string) error {
+	for key, replacement := range codes {
+		code, err := strconv.ParseInt(key, 16, 32)
+		if err != nil {
+			return fmt.Errorf("invalid gaiji code '%s' in %s", key, source)
+		}
+		font[int(code)] = replacement
+	}
+	return nil
+}
+
+func (tables epwingGaijiTables) load(data []byte, source string) error {
+	var file epwingGaijiFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse gaiji table %s: %w", source, err)
+	}
+	if len(file.Subbooks) == 0 {
+		return fmt.Errorf("gaiji table %s does not list any subbooks", source)
+	}
+	for _, subbook := range file.Subbooks {
+		table := tables.get(subbook)
+		if err := parseGaijiCodes(file.Narrow, table.narrow, source); err != nil {
+			return err
+		}
+		if err := parseGaijiCodes(file.Wide, table.wide, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type epwingGaijiMiss struct {
+	count   int
+	heading string
+	order   int
+}
+
+// Collects the gaiji codes without a replacement, keyed by subbook
+// title and then by code (e.g. "w_B021"). Codes are reported from
+// several goroutines; the example heading is the one of the first entry
+// in book order.
+type epwingGaijiReport struct {
+	mutex  sync.Mutex
+	misses map[string]map[string]*epwingGaijiMiss
+}
+
+func (report *epwingGaijiReport) add(subbook, font string, code int, heading string, order int) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+
+	if report.misses == nil {
+		report.misses = map[string]map[string]*epwingGaijiMiss{}
+	}
+	if report.misses[subbook] == nil {
+		report.misses[subbook] = map[string]*epwingGaijiMiss{}
+	}
+	key := fmt.Sprintf("%s_%04X", font, code)
+	miss, ok := report.misses[subbook][key]
+	if !ok {
+		miss = &epwingGaijiMiss{heading: heading, order: order}
+		report.misses[subbook][key] = miss
+	} else if order < miss.order {
+		miss.heading = heading
+		miss.order = order
+	}
+	miss.count += 1
+}
+
+func (report *epwingGaijiReport) print() {
+	subbooks := []string{}
+	for subbook := range report.misses {
+		subbooks = append(subbooks, subbook)
+	}
+	sort.Strings(subbooks)
+
+	for _, subbook := range subbooks {
+		codes := []string{}
+		for code := range report.misses[subbook] {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+
+		var builder strings.Builder
+		fmt.Fprintf(&builder, "Unmapped gaiji in %s (%d codes)\n", subbook, len(codes))
+		for _, code := range codes {
+			miss := report.misses[subbook][code]
+			fmt.Fprintf(&builder, "  %s: %d occurrences, e.g. in %s\n", code, miss.count, miss.heading)
+		}
+		fmt.Print(builder.String())
+	}
+}
+
+// Extensions of the gaiji image files which are looked up, in order.
+var epwingGaijiImageExtensions = []string{".png", ".gif", ".bmp", ".svg"}
+
+// zero-epwing does not expose the bitmaps of a book's fonts, so gaiji
+// images have to be extracted beforehand with another EPWING tool. The
+// image directory holds files named after the font and hexadecimal
+// code of each character (e.g. "w_B021.png"), either directly or in a
+// subdirectory named after the subbook title. Codes without a Unicode
+// replacement but with an image are rendered inline in definitions;
+// headings cannot contain images and keep the replacement character.
+// Images are looked up from several goroutines.
+type epwingGaijiImages struct {
+	mutex     sync.Mutex
+	dir       string
+	subbooks  []string
+	keyToPath map[string]string
+	media     []dbMedia
+}
+
+func newEpwingGaijiImages(dir string) *epwingGaijiImages {
+	if dir == "" {
+		return nil
+	}
+	return &epwingGaijiImages{
+		dir:       dir,
+		keyToPath: map[string]string{},
+	}
+}
+
+// Returns the path of the image inside the dictionary archive, reading
+// and packaging the image file the first time it is requested.
+func (images *epwingGaijiImages) find(subbook, font string, code int) (string, bool) {
+	if images == nil {
+		return "", false
+	}
+	images.mutex.Lock()
+	defer images.mutex.Unlock()
+
+	name := fmt.Sprintf("%s_%04X", font, code)
+	key := subbook + "/" + name
+	if path, ok := images.keyToPath[key]; ok {
+		return path, path != ""
+	}
+
+	images.keyToPath[key] = ""
+	for _, dir := range []string{filepath.Join(images.dir, subbook), images.dir} {
+		for _, extension := range epwingGaijiImageExtensions {
+			data, err := os.ReadFile(filepath.Join(dir, name+extension))
+			if err != nil {
+				continue
+			}
+			// Subbook titles are not used in archive paths since
+			// they may contain characters unsuitable for file names.
+			subbookIndex := slices.Index(images.subbooks, subbook)
+			if subbookIndex == -1 {
+				subbookIndex = len(images.subbooks)
+				images.subbooks = append(images.subbooks, subbook)
+			}
+			path := fmt.Sprintf("gaiji/%d/%s%s", subbookIndex, name, extension)
+			images.keyToPath[key] = path
+			images.media = append(images.media, dbMedia{Path: path, Data: data})
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// The media are sorted by path since the order in which the images are
+// found depends on scheduling.
+func (images *epwingGaijiImages) getMedia() []dbMedia {
+	if images == nil {
+		return nil
+	}
+	media := slices.Clone(images.media)
+	slices.SortFunc(media, func(a, b dbMedia) bool {
+		return a.Path < b.Path
+	})
+	return media
+}
+
+// Converts definitions containing gaiji codes which were left in place
+// by the translation into structured content with inline images.
+func (images *epwingGaijiImages) renderGlossary(term *dbTerm, subbook string, gaijiExp *regexp.Regexp) {
+	if images == nil {
+		return
+	}
+	for i, definition := range term.Glossary {
+		switch v := definition.(type) {
+		case string:
+			if gaijiExp.MatchString(v) {
+				term.Glossary[i] = contentStructure(images.renderText(v, subbook, gaijiExp)...)
+			}
+		case map[string]any:
+			images.renderContent(v, subbook, gaijiExp)
+		}
+	}
+}
+
+// Replaces the gaiji codes in the text nodes of structured content.
+func (images *epwingGaijiImages) renderContent(content any, subbook string, gaijiExp *regexp.Regexp) any {
+	switch v := content.(type) {
+	case string:
+		if gaijiExp.MatchString(v) {
+			return contentReduce(images.renderText(v, subbook, gaijiExp))
+		}
+	case []any:
+		children := []any{}
+		for _, child := range v {
+			rendered := images.renderContent(child, subbook, gaijiExp)
+			if list, ok := rendered.([]any); ok {
+				children = append(children, list...)
+			} else {
+				children = append(children, rendered)
+			}
+		}
+		return children
+	case map[string]any:
+		if child, ok := v["content"]; ok {
+			v["content"] = images.renderContent(child, subbook, gaijiExp)
+		}
+	}
+	return content
+}
+
+func (images *epwingGaijiImages) renderText(text, subbook string, gaijiExp *regexp.Regexp) []any {
+	contents := []any{}
+	last := 0
+	for _, match := range gaijiExp.FindAllStringSubmatchIndex(text, -1) {
+		font := text[match[2]:match[3]]
+		code, _ := strconv.Atoi(text[match[4]:match[5]])
+		path, _ := images.find(subbook, font, code)
+		width := 1.0
+		if font == "n" {
+			width = 0.5
+		}
+		if match[0] > last {
+			contents = append(contents, text[last:match[0]])
+		}
+		attr := contentAttr{
+			verticalAlign: "text-bottom",
+			data:          map[string]string{"content": "gaiji"},
+		}
+		contents = append(contents, contentImage(attr, path, width, 1, ""))
+		last = match[1]
+	}
+	if last < len(text) {
+		contents = append(contents, text[last:])
+	}
+	return contents
+}
@@ -0,0 +1,125 @@
+package yomitan
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestEpwingGaijiTablesLoad(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      []string
+		wantNarrow map[int]string
+		wantWide   map[int]string
+		wantErr    bool
+	}{
+		{
+			name:       "codes",
+			files:      []string{`{"subbooks": ["大辞泉"], "narrow": {"A121": " "}, "wide": {"B021": "嗩", "b022": "凬"}}`},
+			wantNarrow: map[int]string{0xa121: " "},
+			wantWide:   map[int]string{0xb021: "嗩", 0xb022: "凬"},
+		},
+		{
+			name: "overrides",
+			files: []string{
+				`{"subbooks": ["大辞泉"], "wide": {"B021": "嗩", "B022": "凬"}}`,
+				`{"subbooks": ["大辞泉", "明鏡国語辞典"], "wide": {"B022": "風"}}`,
+			},
+			wantNarrow: map[int]string{},
+			wantWide:   map[int]string{0xb021: "嗩", 0xb022: "風"},
+		},
+		{
+			name:    "invalid code",
+			files:   []string{`{"subbooks": ["大辞泉"], "wide": {"B02G": "嗩"}}`},
+			wantErr: true,
+		},
+		{
+			name:    "code out of range",
+			files:   []string{`{"subbooks": ["大辞泉"], "wide": {"1B0210000": "嗩"}}`},
+			wantErr: true,
+		},
+		{
+			name:    "no subbooks",
+			files:   []string{`{"wide": {"B021": "嗩"}}`},
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			files:   []string{`{"subbooks": "大辞泉"}`},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tables := epwingGaijiTables{}
+			var err error
+			for _, file := range test.files {
+				if err = tables.load([]byte(file), test.name); err != nil {
+					break
+				}
+			}
+			if test.wantErr {
+				if err == nil {
+					t.Error("load() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			table := tables.get("大辞泉")
+			if !reflect.DeepEqual(table.narrow, test.wantNarrow) {
+				t.Errorf("narrow = %v, want %v", table.narrow, test.wantNarrow)
+			}
+			if !reflect.DeepEqual(table.wide, test.wantWide) {
+				t.Errorf("wide = %v, want %v", table.wide, test.wantWide)
+			}
+		})
+	}
+}
+
+func TestLoadEpwingGaiji(t *testing.T) {
+	// The built-in tables are loaded first, so user tables override
+	// their replacements.
+	builtin, err := loadEpwingData("", "", map[string]epwingExtractor{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, subbook := range []string{"大辞泉", "明鏡国語辞典", "三省堂　スーパー大辞林", "学研国語大辞典", "学研漢和大字典"} {
+		table := builtin.get(subbook)
+		if len(table.narrow)+len(table.wide) == 0 {
+			t.Errorf("no built-in gaiji for %s", subbook)
+		}
+	}
+
+	code := -1
+	for c := range builtin.get("大辞泉").wide {
+		if code == -1 || c < code {
+			code = c
+		}
+	}
+	path := filepath.Join(t.TempDir(), "gaiji.json")
+	data := []byte(`{"subbooks": ["大辞泉", "独自の辞典"], "wide": {"` + fmt.Sprintf("%04X", code) + `": "〓", "FFFF": "★"}}`)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tables, err := loadEpwingData(path, "", map[string]epwingExtractor{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tables.get("大辞泉").wide[code]; got != "〓" {
+		t.Errorf("overridden code = %q, want %q", got, "〓")
+	}
+	if got := tables.get("独自の辞典").wide[0xffff]; got != "★" {
+		t.Errorf("added code = %q, want %q", got, "★")
+	}
+	if got, want := len(tables.get("大辞泉").wide), len(builtin.get("大辞泉").wide)+1; got != want {
+		t.Errorf("%d wide codes, want %d", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+package yomitan
+
+import (
+	"regexp"
+	"strings"
+
+	zig "github.com/themoeway/zero-epwing-go"
+)
+
+// The generic extractor is used for subbooks without a dedicated
+// extractor. It recognizes the most common heading layouts of
+// Japanese EPWING books, e.g. "あい【愛】", "あい〔愛〕" and "「愛」",
+// and keeps the full text of each entry as its definition.
+type genericExtractor struct {
+	bracketExp   *regexp.Regexp
+	quoteExp     *regexp.Regexp
+	metaExp      *regexp.Regexp
+	readGroupExp *regexp.Regexp
+	kanaExp      *regexp.Regexp
+}
+
+func makeGenericExtractor() epwingExtractor {
+	return &genericExtractor{
+		bracketExp:   regexp.MustCompile(`^([^【〔「]*)[【〔]([^】〕]+)[】〕]`),
+		quoteExp:     regexp.MustCompile(`^「([^」]+)」`),
+		metaExp:      regexp.MustCompile(`[（(][^）)]*[）)]`),
+		readGroupExp: regexp.MustCompile(`[‐\-・▽▼]+`),
+		kanaExp:      regexp.MustCompile(`^[ぁ-ゖァ-ヺー]+$`),
+	}
+}
+
+func (e *genericExtractor) cleanReading(reading string) string {
+	reading = e.metaExp.ReplaceAllLiteralString(reading, "")
+	reading = e.readGroupExp.ReplaceAllLiteralString(reading, "")
+	return strings.TrimSpace(reading)
+}
+
+func (e *genericExtractor) extractTerms(entry zig.BookEntry, sequence int) []dbTerm {
+	heading := strings.TrimSpace(entry.Heading)
+	if heading == "" {
+		return nil
+	}
+
+	var expressions []string
+	var reading string
+	if matches := e.bracketExp.FindStringSubmatch(heading); matches != nil {
+		reading = e.cleanReading(matches[1])
+		for _, expression := range strings.Split(matches[2], "・") {
+			expression = strings.TrimSpace(e.metaExp.ReplaceAllLiteralString(expression, ""))
+			if expression != "" {
+				expressions = append(expressions, expression)
+			}
+		}
+	} else if matches := e.quoteExp.FindStringSubmatch(heading); matches != nil {
+		expressions = append(expressions, strings.TrimSpace(matches[1]))
+	} else {
+		expressions = append(expressions, e.cleanReading(heading))
+	}
+
+	if reading != "" && !e.kanaExp.MatchString(reading) {
+		// Not a reading after all, e.g. a heading of the form
+		// "漢字【注記】".
+		expressions = append([]string{reading}, expressions...)
+		reading = ""
+	}
+
+	var terms []dbTerm
+	if len(expressions) == 0 && reading != "" {
+		expressions = append(expressions, reading)
+	}
+	for _, expression := range expressions {
+		if expression == "" {
+			continue
+		}
+		term := dbTerm{
+			Expression: expression,
+			Reading:    reading,
+			Glossary:   []any{entry.Text},
+			Sequence:   sequence,
+		}
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+func (*genericExtractor) extractKanji(entry zig.BookEntry) []dbKanji {
+	return nil
+}
+
+func (*genericExtractor) extractPitch(entry zig.BookEntry, terms []dbTerm) []dbMeta {
+	return nil
+}
+
+func (*genericExtractor) getTags() []dbTag {
+	return nil
+}
+
+func (*genericExtractor) getReferenceExps() []*regexp.Regexp {
+	return epwingDefaultReferenceExps
+}
+
+func (*genericExtractor) getRevision() string {
+	return "generic1"
+}
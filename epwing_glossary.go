@@ -0,0 +1,152 @@
+package yomitan
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Sense markers of monolingual dictionaries. Books use several marker
+// families for different levels (e.g. ❶ for groups of ① senses), so only
+// the family which appears first in a definition starts list items and
+// the others are kept as text.
+var epwingSenseExps = []*regexp.Regexp{
+	regexp.MustCompile(`[①-⑳㉑-㉟㊱-㊿]`),
+	regexp.MustCompile(`[❶-❿⓫-⓴]`),
+	regexp.MustCompile(`(?m)^[１-９][０-９]*[ 　]?`),
+}
+
+const epwingExampleMarker = "▶"
+
+// Reports whether a sense marker at start begins its line, ignoring
+// any meta blocks in front of it, as in （名）①…. Markers inside the
+// text, such as the ② of ⇒あう②, are references rather than senses.
+func epwingSenseMarkerStartsLine(text string, start int, metaExp *regexp.Regexp) bool {
+	prefix := text[strings.LastIndex(text[:start], "\n")+1 : start]
+	for metaExp != nil {
+		prefix = strings.TrimSpace(prefix)
+		loc := metaExp.FindStringIndex(prefix)
+		if loc == nil || loc[0] != 0 || loc[1] == 0 {
+			break
+		}
+		prefix = prefix[loc[1]:]
+	}
+	return strings.TrimSpace(prefix) == ""
+}
+
+// Returns the locations of the sense markers of the family which appears
+// first in the text. Markers rejected by keep are left in the text; a nil
+// keep accepts every marker.
+func epwingSenseLocs(text string, keep func(start int) bool) [][]int {
+	var senseLocs [][]int
+	for _, exp := range epwingSenseExps {
+		var locs [][]int
+		for _, loc := range exp.FindAllStringIndex(text, -1) {
+			if keep == nil || keep(loc[0]) {
+				locs = append(locs, loc)
+			}
+		}
+		if len(locs) > 0 && (senseLocs == nil || locs[0][0] < senseLocs[0][0]) {
+			senseLocs = locs
+		}
+	}
+	return senseLocs
+}
+
+// Renders the text of a monolingual definition as structured content:
+// numbered senses become an ordered list, the ▶ examples of each sense
+// become a sub-list, and blocks matching metaExp (e.g. （名） or ［動五］)
+// at the start of a line are styled as meta information. Definitions
+// without any of them are returned as plain text.
+func epwingDefinitionContent(text string, metaExp *regexp.Regexp) any {
+	senseLocs := epwingSenseLocs(text, func(start int) bool {
+		return epwingSenseMarkerStartsLine(text, start, metaExp)
+	})
+
+	preamble := text
+	if len(senseLocs) > 0 {
+		preamble = text[:senseLocs[0][0]]
+	}
+
+	structured := len(senseLocs) > 0
+	contents := []any{}
+	if preambleContents, ok := epwingSegmentContent(preamble, metaExp); len(preambleContents) > 0 {
+		attr := contentAttr{data: map[string]string{"content": "definitionHeader"}}
+		contents = append(contents, contentDiv(attr, preambleContents...))
+		structured = structured || ok
+	}
+
+	senseListItems := []any{}
+	for i, loc := range senseLocs {
+		end := len(text)
+		if i+1 < len(senseLocs) {
+			end = senseLocs[i+1][0]
+		}
+		senseContents, _ := epwingSegmentContent(text[loc[1]:end], metaExp)
+		if len(senseContents) == 0 {
+			senseContents = append(senseContents, "")
+		}
+		attr := contentAttr{data: map[string]string{"content": "sense"}}
+		senseListItems = append(senseListItems, contentListItem(attr, senseContents...))
+	}
+	if len(senseListItems) > 0 {
+		contents = append(contents, contentOrderedList(listAttr("ja", "", "senses"), senseListItems...))
+	}
+
+	if !structured {
+		return text
+	}
+	return contentStructure(contents...)
+}
+
+// Returns the contents of a definition segment and whether any of them
+// are structured.
+func epwingSegmentContent(segment string, metaExp *regexp.Regexp) ([]any, bool) {
+	parts := strings.Split(segment, epwingExampleMarker)
+	contents, structured := epwingBodyContent(strings.Trim(parts[0], "\n"), metaExp)
+
+	exampleListItems := []any{}
+	for _, example := range parts[1:] {
+		if example = strings.TrimSpace(example); example != "" {
+			attr := contentAttr{data: map[string]string{"content": "example"}}
+			exampleListItems = append(exampleListItems, contentListItem(attr, example))
+		}
+	}
+	if len(exampleListItems) > 0 {
+		contents = append(contents, contentUnorderedList(listAttr("ja", "circle", "examples"), exampleListItems...))
+		structured = true
+	}
+
+	return contents, structured
+}
+
+func epwingBodyContent(body string, metaExp *regexp.Regexp) ([]any, bool) {
+	if body == "" {
+		return nil, false
+	}
+
+	contents := []any{}
+	structured := false
+	for i, line := range strings.Split(body, "\n") {
+		if i > 0 {
+			contents = append(contents, "\n")
+		}
+		for metaExp != nil {
+			loc := metaExp.FindStringIndex(line)
+			if loc == nil || loc[0] != 0 || loc[1] == 0 {
+				break
+			}
+			attr := contentAttr{
+				fontSize: "smaller",
+				data:     map[string]string{"content": "meta"},
+			}
+			contents = append(contents, contentSpan(attr, line[:loc[1]]))
+			line = line[loc[1]:]
+			structured = true
+		}
+		if line != "" {
+			contents = append(contents, line)
+		}
+	}
+
+	return contents, structured
+}
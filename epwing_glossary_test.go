@@ -0,0 +1,122 @@
+package yomitan
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestEpwingDefinitionContent(t *testing.T) {
+	metaExp := regexp.MustCompile(`［([^］]*)］`)
+	parenMetaExp := regexp.MustCompile(`（[^）]*）`)
+	meta := func(text string) any {
+		return contentSpan(contentAttr{fontSize: "smaller", data: map[string]string{"content": "meta"}}, text)
+	}
+	header := func(contents ...any) any {
+		return contentDiv(contentAttr{data: map[string]string{"content": "definitionHeader"}}, contents...)
+	}
+	sense := func(contents ...any) any {
+		return contentListItem(contentAttr{data: map[string]string{"content": "sense"}}, contents...)
+	}
+	senses := func(items ...any) any {
+		return contentOrderedList(listAttr("ja", "", "senses"), items...)
+	}
+	example := func(text string) any {
+		return contentListItem(contentAttr{data: map[string]string{"content": "example"}}, text)
+	}
+	examples := func(items ...any) any {
+		return contentUnorderedList(listAttr("ja", "circle", "examples"), items...)
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		metaExp *regexp.Regexp
+		want    any
+	}{
+		{
+			name:    "plain text",
+			text:    "ただの説明。",
+			metaExp: metaExp,
+			want:    "ただの説明。",
+		},
+		{
+			name:    "plain text without meta expression",
+			text:    "［名］説明。",
+			metaExp: nil,
+			want:    "［名］説明。",
+		},
+		{
+			name:    "meta",
+			text:    "［名］説明。",
+			metaExp: metaExp,
+			want:    contentStructure(header(meta("［名］"), "説明。")),
+		},
+		{
+			name:    "senses and examples",
+			text:    "［名］\n①はじめ。▶例一。▶例二。\n②つぎ。",
+			metaExp: metaExp,
+			want: contentStructure(
+				header(meta("［名］")),
+				senses(
+					sense("はじめ。", examples(example("例一。"), example("例二。"))),
+					sense("つぎ。"),
+				),
+			),
+		},
+		{
+			name:    "nested sense markers",
+			text:    "前書き\n❶まとめ①一。②二。\n❷べつ。",
+			metaExp: metaExp,
+			want: contentStructure(
+				header("前書き"),
+				senses(sense("まとめ①一。②二。"), sense("べつ。")),
+			),
+		},
+		{
+			name:    "senses after meta",
+			text:    "（名）①はじめ。\n②つぎ。",
+			metaExp: parenMetaExp,
+			want: contentStructure(
+				header(meta("（名）")),
+				senses(sense("はじめ。"), sense("つぎ。")),
+			),
+		},
+		{
+			name:    "inline marker",
+			text:    "（名）\n「あい（愛）①」に同じ。",
+			metaExp: parenMetaExp,
+			want:    contentStructure(header(meta("（名）"), "\n", "「あい（愛）①」に同じ。")),
+		},
+		{
+			name:    "inline marker in a sense",
+			text:    "（名）\n①はじめ。⇒あう②\n②つぎ。",
+			metaExp: parenMetaExp,
+			want: contentStructure(
+				header(meta("（名）")),
+				senses(sense("はじめ。⇒あう②"), sense("つぎ。")),
+			),
+		},
+		{
+			name:    "numbered lines",
+			text:    "１ はじめ。\n２ つぎ。",
+			metaExp: nil,
+			want:    contentStructure(senses(sense("はじめ。"), sense("つぎ。"))),
+		},
+		{
+			name:    "examples only",
+			text:    "説明。▶例。",
+			metaExp: nil,
+			want:    contentStructure(header("説明。", examples(example("例。")))),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := epwingDefinitionContent(test.text, test.metaExp)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("epwingDefinitionContent() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
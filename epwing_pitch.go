@@ -0,0 +1,100 @@
+package yomitan
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/width"
+)
+
+const (
+	epwingPitchInclude   = "include"
+	epwingPitchCompanion = "companion"
+)
+
+var (
+	// Accent numbers as found in the headings of most monolingual
+	// books, e.g. "あう［１］【会う】" or "ひと［０・２］【人】".
+	epwingDefaultPitchExp = regexp.MustCompile(`［([0-9０-９]+(?:[・,，][0-9０-９]+)*)］`)
+
+	epwingPitchSplitExp = regexp.MustCompile(`[・,，]`)
+	epwingPitchKanaExp  = regexp.MustCompile(`^[ぁ-ヿ]+$`)
+)
+
+type epwingPitchPosition struct {
+	Position int `json:"position"`
+}
+
+type epwingPitch struct {
+	Reading string                `json:"reading"`
+	Pitches []epwingPitchPosition `json:"pitches"`
+}
+
+// Returns the accent positions in the first group of the first match of
+// exp in text.
+func epwingPitchPositions(text string, exp *regexp.Regexp) []int {
+	if exp == nil {
+		return nil
+	}
+	matches := exp.FindStringSubmatch(text)
+	if matches == nil || len(matches) < 2 {
+		return nil
+	}
+
+	var positions []int
+	for _, number := range epwingPitchSplitExp.Split(matches[1], -1) {
+		if position, err := strconv.Atoi(width.Narrow.String(number)); err == nil {
+			positions = append(positions, position)
+		}
+	}
+	return positions
+}
+
+// Makes pitch records for the expression and reading pairs of the terms
+// of an entry. Terms without a reading are used if their expression is
+// written in kana.
+func epwingPitchMeta(terms []dbTerm, positions []int) []dbMeta {
+	if len(positions) == 0 {
+		return nil
+	}
+
+	var pitches []epwingPitchPosition
+	for _, position := range positions {
+		pitches = append(pitches, epwingPitchPosition{position})
+	}
+
+	var metas []dbMeta
+	seen := map[string]bool{}
+	for _, term := range terms {
+		reading := term.Reading
+		if reading == "" {
+			if !epwingPitchKanaExp.MatchString(term.Expression) {
+				continue
+			}
+			reading = term.Expression
+		}
+		key := term.Expression + "\x00" + reading
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		metas = append(metas, dbMeta{term.Expression, "pitch", epwingPitch{reading, pitches}})
+	}
+	return metas
+}
+
+func epwingCheckPitchMode(mode string) error {
+	switch mode {
+	case "", epwingPitchInclude, epwingPitchCompanion:
+		return nil
+	default:
+		return fmt.Errorf("unrecognized pitch mode '%s' [%s|%s]", mode, epwingPitchInclude, epwingPitchCompanion)
+	}
+}
+
+func epwingPitchCompanionPath(outputPath string) string {
+	return strings.TrimSuffix(outputPath, filepath.Ext(outputPath)) + "_pitch.zip"
+}
@@ -0,0 +1,88 @@
+package yomitan
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestEpwingPitchPositions(t *testing.T) {
+	tests := []struct {
+		name    string
+		heading string
+		exp     *regexp.Regexp
+		want    []int
+	}{
+		{
+			name:    "full-width",
+			heading: "あう［１］【会う】",
+			exp:     epwingDefaultPitchExp,
+			want:    []int{1},
+		},
+		{
+			name:    "several positions",
+			heading: "ひと［０・２］【人】",
+			exp:     epwingDefaultPitchExp,
+			want:    []int{0, 2},
+		},
+		{
+			name:    "comma-separated",
+			heading: "かわ［2，0］【川】",
+			exp:     epwingDefaultPitchExp,
+			want:    []int{2, 0},
+		},
+		{
+			name:    "first match",
+			heading: "あう［１］【会う】［３］",
+			exp:     epwingDefaultPitchExp,
+			want:    []int{1},
+		},
+		{
+			name:    "no accent",
+			heading: "あう【会う】",
+			exp:     epwingDefaultPitchExp,
+		},
+		{
+			name:    "no expression",
+			heading: "あう［１］【会う】",
+		},
+		{
+			name:    "expression without group",
+			heading: "あう［１］【会う】",
+			exp:     regexp.MustCompile(`［[０-９]+］`),
+		},
+		{
+			name:    "custom expression",
+			heading: "あう〈1〉",
+			exp:     regexp.MustCompile(`〈([0-9]+)〉`),
+			want:    []int{1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := epwingPitchPositions(test.heading, test.exp); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("epwingPitchPositions() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEpwingPitchMeta(t *testing.T) {
+	terms := []dbTerm{
+		{Expression: "会う", Reading: "あう"},
+		{Expression: "会う", Reading: "あう"},
+		{Expression: "あう"},
+		{Expression: "逢"},
+	}
+	want := []dbMeta{
+		{"会う", "pitch", epwingPitch{"あう", []epwingPitchPosition{{1}, {0}}}},
+		{"あう", "pitch", epwingPitch{"あう", []epwingPitchPosition{{1}, {0}}}},
+	}
+	if got := epwingPitchMeta(terms, []int{1, 0}); !reflect.DeepEqual(got, want) {
+		t.Errorf("epwingPitchMeta() = %v, want %v", got, want)
+	}
+	if got := epwingPitchMeta(terms, nil); got != nil {
+		t.Errorf("epwingPitchMeta() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,232 @@
+package yomitan
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Cross-reference patterns used by books which do not define their own.
+// The group named "ref" (or the first group) holds the referenced
+// headword, which is either a plain expression or reading, or of the
+// form "よみ【表記】".
+var epwingDefaultReferenceExps = []*regexp.Regexp{
+	regexp.MustCompile(`[⇒→]\s*(?P<ref>[^\s。、，,；;：:（）()「」『』⇒→]+(?:【[^】]+】)?)`),
+	regexp.MustCompile(`「(?P<ref>[^「」]+)」を見よ`),
+}
+
+var (
+	epwingReferenceHeadwordExp = regexp.MustCompile(`^([^【]*)【([^】]+)】$`)
+	epwingReferenceReadingExp  = regexp.MustCompile(`[‐\-・]+`)
+)
+
+// Replaces the cross-reference expressions of an extractor, see
+// overrideReferenceExps.
+type epwingReferenceOverride struct {
+	epwingExtractor
+	referenceExps []*regexp.Regexp
+}
+
+func (e *epwingReferenceOverride) getReferenceExps() []*regexp.Regexp {
+	return e.referenceExps
+}
+
+type epwingReferenceMiss struct {
+	count    int
+	heading  string
+	sequence int
+}
+
+// Collects the cross-references whose headword does not exist in the
+// book, keyed by subbook title and then by reference.
+type epwingReferenceReport map[string]map[string]*epwingReferenceMiss
+
+func (report epwingReferenceReport) add(subbook, ref, heading string, sequence int) {
+	if report[subbook] == nil {
+		report[subbook] = map[string]*epwingReferenceMiss{}
+	}
+	miss, ok := report[subbook][ref]
+	if !ok {
+		miss = &epwingReferenceMiss{heading: heading, sequence: -1}
+		report[subbook][ref] = miss
+	}
+	// Terms of the same entry share their definition, which must
+	// only be counted once.
+	if miss.sequence != sequence {
+		miss.sequence = sequence
+		miss.count += 1
+	}
+}
+
+func (report epwingReferenceReport) print() {
+	subbooks := []string{}
+	for subbook := range report {
+		subbooks = append(subbooks, subbook)
+	}
+	sort.Strings(subbooks)
+
+	for _, subbook := range subbooks {
+		refs := []string{}
+		for ref := range report[subbook] {
+			refs = append(refs, ref)
+		}
+		sort.Strings(refs)
+
+		var builder strings.Builder
+		fmt.Fprintf(&builder, "Unresolved references in %s (%d headwords)\n", subbook, len(refs))
+		for _, ref := range refs {
+			miss := report[subbook][ref]
+			fmt.Fprintf(&builder, "  %s: %d occurrences, e.g. in %s\n", ref, miss.count, miss.heading)
+		}
+		fmt.Print(builder.String())
+	}
+}
+
+type epwingReferenceLinker struct {
+	subbook   string
+	exps      []*regexp.Regexp
+	headwords map[string]bool
+	report    epwingReferenceReport
+}
+
+// Turns the cross-references in the definitions of a subbook into links
+// to the referenced headwords, which must exist among its terms.
+func epwingLinkReferences(terms []dbTerm, subbook string, exps []*regexp.Regexp, report epwingReferenceReport) {
+	if len(exps) == 0 {
+		return
+	}
+
+	linker := epwingReferenceLinker{
+		subbook:   subbook,
+		exps:      exps,
+		headwords: map[string]bool{},
+		report:    report,
+	}
+	for _, term := range terms {
+		linker.headwords[term.Expression] = true
+		if term.Reading != "" {
+			linker.headwords[term.Reading] = true
+		}
+	}
+
+	for i := range terms {
+		term := &terms[i]
+		for j, definition := range term.Glossary {
+			switch v := definition.(type) {
+			case string:
+				if contents, ok := linker.linkText(v, term); ok {
+					term.Glossary[j] = contentStructure(contents...)
+				}
+			case map[string]any:
+				term.Glossary[j] = linker.linkContent(v, term)
+			}
+		}
+	}
+}
+
+// Replaces the references in the text nodes of structured content. The
+// terms of an entry share their glossary, so the content is copied
+// rather than modified in place.
+func (linker *epwingReferenceLinker) linkContent(content any, term *dbTerm) any {
+	switch v := content.(type) {
+	case string:
+		if contents, ok := linker.linkText(v, term); ok {
+			return contentReduce(contents)
+		}
+	case []any:
+		children := []any{}
+		for _, child := range v {
+			linked := linker.linkContent(child, term)
+			if list, ok := linked.([]any); ok {
+				children = append(children, list...)
+			} else {
+				children = append(children, linked)
+			}
+		}
+		return children
+	case map[string]any:
+		// Links are not nested.
+		if v["tag"] == "a" {
+			break
+		}
+		if child, ok := v["content"]; ok {
+			copied := make(map[string]any, len(v))
+			for key, value := range v {
+				copied[key] = value
+			}
+			copied["content"] = linker.linkContent(child, term)
+			return copied
+		}
+	}
+	return content
+}
+
+// Returns the query for a referenced headword, trying the expressions
+// and then the reading of references of the form "よみ【表記】".
+func (linker *epwingReferenceLinker) resolve(ref string) (string, bool) {
+	candidates := []string{ref}
+	if matches := epwingReferenceHeadwordExp.FindStringSubmatch(ref); matches != nil {
+		candidates = strings.Split(matches[2], "・")
+		candidates = append(candidates, epwingReferenceReadingExp.ReplaceAllLiteralString(matches[1], ""))
+	}
+	for _, candidate := range candidates {
+		if candidate = strings.TrimSpace(candidate); linker.headwords[candidate] {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+func (linker *epwingReferenceLinker) linkText(text string, term *dbTerm) ([]any, bool) {
+	var locs [][]int
+	for _, exp := range linker.exps {
+		group := exp.SubexpIndex("ref")
+		if group == -1 {
+			group = 1
+		}
+		for _, match := range exp.FindAllStringSubmatchIndex(text, -1) {
+			if match[2*group] != -1 {
+				locs = append(locs, match[2*group:2*group+2])
+			}
+		}
+	}
+	if len(locs) == 0 {
+		return nil, false
+	}
+	sort.SliceStable(locs, func(i, j int) bool {
+		return locs[i][0] < locs[j][0]
+	})
+
+	contents := []any{}
+	linked := false
+	last := 0
+	for _, loc := range locs {
+		if loc[0] < last {
+			continue
+		}
+		ref := text[loc[0]:loc[1]]
+		query, ok := linker.resolve(ref)
+		if !ok {
+			linker.report.add(linker.subbook, ref, term.Expression, term.Sequence)
+			continue
+		}
+		// Entries often mention their own headword, e.g. in the
+		// references of their variant forms, which needs no link.
+		if query == term.Expression || query == term.Reading {
+			continue
+		}
+		if loc[0] > last {
+			contents = append(contents, text[last:loc[0]])
+		}
+		attr := contentAttr{data: map[string]string{"content": "reference"}}
+		contents = append(contents, contentInternalLink(attr, query, ref))
+		last = loc[1]
+		linked = true
+	}
+	if last < len(text) {
+		contents = append(contents, text[last:])
+	}
+
+	return contents, linked
+}
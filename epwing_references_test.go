@@ -0,0 +1,153 @@
+package yomitan
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestEpwingReferenceResolve(t *testing.T) {
+	linker := epwingReferenceLinker{headwords: map[string]bool{
+		"会う":  true,
+		"あう":  true,
+		"逢う":  true,
+		"あいて": true,
+	}}
+
+	tests := []struct {
+		ref    string
+		want   string
+		wantOk bool
+	}{
+		{"会う", "会う", true},
+		{"あう【会う】", "会う", true},
+		{"あう【遭う・逢う】", "逢う", true},
+		{"あい‐て【相手】", "あいて", true},
+		{"いう【言う】", "", false},
+		{"言う", "", false},
+	}
+
+	for _, test := range tests {
+		got, ok := linker.resolve(test.ref)
+		if got != test.want || ok != test.wantOk {
+			t.Errorf("resolve(%q) = %q, %v, want %q, %v", test.ref, got, ok, test.want, test.wantOk)
+		}
+	}
+}
+
+func TestEpwingLinkReferences(t *testing.T) {
+	link := func(query, text string) any {
+		return contentInternalLink(contentAttr{data: map[string]string{"content": "reference"}}, query, text)
+	}
+
+	tests := []struct {
+		name       string
+		definition any
+		want       any
+		wantMisses []string
+	}{
+		{
+			name:       "arrow",
+			definition: "出会う。⇒あう【会う】",
+			want:       contentStructure("出会う。⇒", link("会う", "あう【会う】")),
+		},
+		{
+			name:       "see also",
+			definition: "「会う」を見よ。",
+			want:       contentStructure("「", link("会う", "会う"), "」を見よ。"),
+		},
+		{
+			name:       "several references",
+			definition: "→会う。→見る",
+			want:       contentStructure("→", link("会う", "会う"), "。→", link("見る", "見る")),
+		},
+		{
+			name:       "self-reference",
+			definition: "⇒あう【逢う】",
+			want:       "⇒あう【逢う】",
+		},
+		{
+			name:       "unresolved",
+			definition: "⇒言う",
+			want:       "⇒言う",
+			wantMisses: []string{"言う"},
+		},
+		{
+			name:       "structured content",
+			definition: contentStructure(contentDiv(contentAttr{}, "⇒見る")),
+			want:       contentStructure(contentDiv(contentAttr{}, "⇒", link("見る", "見る"))),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			terms := []dbTerm{
+				{Expression: "逢う", Reading: "あう", Glossary: []any{test.definition}},
+				{Expression: "会う", Reading: "あう"},
+				{Expression: "見る", Reading: "みる"},
+			}
+			report := epwingReferenceReport{}
+			epwingLinkReferences(terms, "辞典", epwingDefaultReferenceExps, report)
+
+			if got := terms[0].Glossary[0]; !reflect.DeepEqual(got, test.want) {
+				t.Errorf("glossary = %v, want %v", got, test.want)
+			}
+			var misses []string
+			for ref := range report["辞典"] {
+				misses = append(misses, ref)
+			}
+			if !reflect.DeepEqual(misses, test.wantMisses) {
+				t.Errorf("unresolved references = %v, want %v", misses, test.wantMisses)
+			}
+		})
+	}
+}
+
+func TestEpwingLinkSharedGlossary(t *testing.T) {
+	// Terms of the same entry share one glossary value.
+	glossary := contentStructure(contentDiv(contentAttr{}, "⇒あう【会う】"))
+	terms := []dbTerm{
+		{Expression: "逢う", Reading: "あう", Glossary: []any{glossary}},
+		{Expression: "会う", Reading: "あう", Glossary: []any{glossary}},
+	}
+	epwingLinkReferences(terms, "辞典", epwingDefaultReferenceExps, epwingReferenceReport{})
+
+	link := contentInternalLink(contentAttr{data: map[string]string{"content": "reference"}}, "会う", "あう【会う】")
+	want := contentStructure(contentDiv(contentAttr{}, "⇒", link))
+	if got := terms[0].Glossary[0]; !reflect.DeepEqual(got, want) {
+		t.Errorf("glossary of 逢う = %v, want %v", got, want)
+	}
+	unlinked := contentStructure(contentDiv(contentAttr{}, "⇒あう【会う】"))
+	if got := terms[1].Glossary[0]; !reflect.DeepEqual(got, unlinked) {
+		t.Errorf("glossary of 会う = %v, want %v", got, unlinked)
+	}
+	if !reflect.DeepEqual(glossary, unlinked) {
+		t.Errorf("shared glossary was modified: %v", glossary)
+	}
+}
+
+func TestEpwingReferenceOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "references.json")
+	data := []byte(`{"subbooks": ["大辞泉"], "references": ["参照：(?P<ref>\\S+)"]}`)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	extractors := map[string]epwingExtractor{"大辞泉": makeDaijisenExtractor(true)}
+	if _, err := loadEpwingData("", path, extractors, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Everything but the reference expressions is left to the
+	// overridden extractor.
+	extractor := extractors["大辞泉"]
+	if got, want := extractor.getRevision(), makeDaijisenExtractor(true).getRevision(); got != want {
+		t.Errorf("revision = %q, want %q", got, want)
+	}
+	want := []*regexp.Regexp{regexp.MustCompile(`参照：(?P<ref>\S+)`)}
+	if got := extractor.getReferenceExps(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reference expressions = %v, want %v", got, want)
+	}
+}
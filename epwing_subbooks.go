@@ -0,0 +1,68 @@
+package yomitan
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	zig "github.com/themoeway/zero-epwing-go"
+	"golang.org/x/exp/slices"
+)
+
+// Subbooks are selected with a comma-separated list of their titles or
+// of their 1-based indices as shown by epwingListSubbooks. The selected
+// indices are returned in book order.
+func epwingSelectSubbooks(book *zig.Book, selection string) ([]int, error) {
+	var indices []int
+	if selection == "" {
+		for i := range book.Subbooks {
+			indices = append(indices, i)
+		}
+		return indices, nil
+	}
+
+	for _, item := range strings.Split(selection, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		index := slices.IndexFunc(book.Subbooks, func(subbook zig.BookSubbook) bool {
+			return subbook.Title == item
+		})
+		if number, err := strconv.Atoi(item); index == -1 && err == nil {
+			if number < 1 || number > len(book.Subbooks) {
+				return nil, fmt.Errorf("subbook index %d is out of range (1-%d)", number, len(book.Subbooks))
+			}
+			index = number - 1
+		}
+		if index == -1 {
+			return nil, fmt.Errorf("unknown subbook '%s'", item)
+		}
+		if !slices.Contains(indices, index) {
+			indices = append(indices, index)
+		}
+	}
+	slices.Sort(indices)
+
+	return indices, nil
+}
+
+func epwingListSubbooks(book *zig.Book, extractors map[string]epwingExtractor) {
+	for i, subbook := range book.Subbooks {
+		revision := "generic extractor"
+		if extractor, ok := extractors[subbook.Title]; ok {
+			revision = extractor.getRevision()
+		}
+		fmt.Printf("%d. %s (%d entries, %s)\n", i+1, subbook.Title, len(subbook.Entries), revision)
+	}
+}
+
+var epwingFileNameExp = regexp.MustCompile(`[\s　/\\:*?"<>|]+`)
+
+// Subbook titles may contain characters unsuitable for file names, and
+// different subbooks may share a title, hence the index prefix.
+func epwingSubbookFileName(index int, title string) string {
+	name := epwingFileNameExp.ReplaceAllLiteralString(title, "_")
+	return fmt.Sprintf("%02d_%s.zip", index+1, name)
+}
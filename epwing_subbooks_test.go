@@ -0,0 +1,100 @@
+package yomitan
+
+import (
+	"reflect"
+	"testing"
+
+	zig "github.com/themoeway/zero-epwing-go"
+)
+
+func TestEpwingSelectSubbooks(t *testing.T) {
+	book := &zig.Book{Subbooks: []zig.BookSubbook{
+		{Title: "学研国語大辞典"},
+		{Title: "古語辞典"},
+		{Title: "故事ことわざ辞典"},
+		{Title: "2"},
+	}}
+
+	tests := []struct {
+		name      string
+		selection string
+		want      []int
+		wantErr   bool
+	}{
+		{
+			name: "all",
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name:      "titles",
+			selection: "故事ことわざ辞典, 学研国語大辞典",
+			want:      []int{0, 2},
+		},
+		{
+			name:      "indices",
+			selection: "3,1",
+			want:      []int{0, 2},
+		},
+		{
+			name:      "duplicates",
+			selection: "古語辞典,2,,古語辞典",
+			want:      []int{1, 3},
+		},
+		{
+			name:      "title before index",
+			selection: "2",
+			want:      []int{3},
+		},
+		{
+			name:      "index out of range",
+			selection: "5",
+			wantErr:   true,
+		},
+		{
+			name:      "zero index",
+			selection: "0",
+			wantErr:   true,
+		},
+		{
+			name:      "unknown title",
+			selection: "大辞泉",
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := epwingSelectSubbooks(book, test.selection)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("epwingSelectSubbooks() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("epwingSelectSubbooks() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEpwingSubbookFileName(t *testing.T) {
+	tests := []struct {
+		index int
+		title string
+		want  string
+	}{
+		{0, "学研国語大辞典", "01_学研国語大辞典.zip"},
+		{4, "研究社　新和英大辞典　第５版", "05_研究社_新和英大辞典_第５版.zip"},
+		{11, "A/B: C?", "12_A_B_C_.zip"},
+	}
+
+	for _, test := range tests {
+		if got := epwingSubbookFileName(test.index, test.title); got != test.want {
+			t.Errorf("epwingSubbookFileName(%d, %q) = %q, want %q", test.index, test.title, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package yomitan
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	zig "github.com/themoeway/zero-epwing-go"
+)
+
+func TestEpwingExtractEntries(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	var entries []zig.BookEntry
+	for i := 0; i < 200; i++ {
+		entries = append(entries, zig.BookEntry{
+			Heading: fmt.Sprintf("ことば%d【言葉%d】", i, i),
+			Text:    fmt.Sprintf("説明%d", i),
+		})
+	}
+
+	extractor := makeGenericExtractor()
+	extract := func(index int) epwingEntryResult {
+		// Later entries finish first.
+		time.Sleep(time.Duration(len(entries)-index) * time.Microsecond)
+		return epwingEntryResult{terms: extractor.extractTerms(entries[index], 100+index)}
+	}
+
+	var want []dbTerm
+	for i := range entries {
+		want = append(want, extract(i).terms...)
+	}
+
+	for run := 0; run < 3; run++ {
+		var got []dbTerm
+		for _, result := range epwingExtractEntries(len(entries), extract) {
+			got = append(got, result.terms...)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("run %d: terms are not in book order", run)
+		}
+	}
+}
+
+func TestEpwingGaijiReportOrder(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	// The example heading of a code is the one of the first entry in
+	// book order, whichever entry is reported first.
+	report := &epwingGaijiReport{}
+	var wg sync.WaitGroup
+	for i := 99; i >= 0; i-- {
+		wg.Add(1)
+		go func(order int) {
+			defer wg.Done()
+			report.add("辞典", "w", 0xb021, fmt.Sprintf("見出し%d", order), order)
+		}(i)
+	}
+	wg.Wait()
+
+	miss := report.misses["辞典"]["w_B021"]
+	if miss.count != 100 {
+		t.Errorf("count = %d, want 100", miss.count)
+	}
+	if miss.heading != "見出し0" {
+		t.Errorf("heading = %q, want %q", miss.heading, "見出し0")
+	}
+}
@@ -7,15 +7,15 @@ import (
 	"strings"
 )
 
-func frequencyTermsExportDb(inputPath, outputPath, language, title string, stride int, pretty bool) error {
-	return frequencyExportDb(inputPath, outputPath, language, title, stride, pretty, "term_meta")
+func frequencyTermsExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	return frequencyExportDb(inputPath, outputPath, language, title, stride, pretty, options, "term_meta")
 }
 
-func frequencyKanjiExportDb(inputPath, outputPath, language, title string, stride int, pretty bool) error {
-	return frequencyExportDb(inputPath, outputPath, language, title, stride, pretty, "kanji_meta")
+func frequencyKanjiExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	return frequencyExportDb(inputPath, outputPath, language, title, stride, pretty, options, "kanji_meta")
 }
 
-func frequencyExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, key string) error {
+func frequencyExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions, key string) error {
 	reader, err := os.Open(inputPath)
 	if err != nil {
 		return err
@@ -8,6 +8,5 @@ require (
 	github.com/themoeway/jmdict-go v0.0.0-20230321060422-fa8f5d54f364
 	github.com/themoeway/zero-epwing-go v0.0.0-20230320143722-0af367763d6c
 	golang.org/x/exp v0.0.0-20221207211629-99ab8fa1c11f
+	golang.org/x/text v0.3.7
 )
-
-require golang.org/x/text v0.3.7 // indirect
@@ -0,0 +1,124 @@
+package yomitan
+
+import (
+	"strings"
+)
+
+const (
+	legacyRuleSet   = "legacy"
+	extendedRuleSet = "extended"
+)
+
+// Deinflection rules emitted in the extended rule set, keyed by
+// JMdict part-of-speech tag. Newer Yomitan language transforms
+// understand the special verb classes, so they are no longer folded
+// into the broad v1/v5/vs rules. Tags without an entry get no rule,
+// e.g. adj-ix, as いい only conjugates through the stem of よい.
+var extendedRuleTable = map[string][]string{
+	"adj-i": {"adj-i"},
+	"v1":    {"v1"},
+	"v1-s":  {"v1-s"},
+	"v5":    {"v5"}, // used by Rikai
+	"v5aru": {"v5aru"},
+	"v5b":   {"v5"},
+	"v5g":   {"v5"},
+	"v5k":   {"v5"},
+	"v5k-s": {"v5k-s"},
+	"v5m":   {"v5"},
+	"v5n":   {"v5"},
+	"v5r":   {"v5"},
+	"v5r-i": {"v5r-i"},
+	"v5s":   {"v5"},
+	"v5t":   {"v5"},
+	"v5u":   {"v5"},
+	"v5u-s": {"v5u-s"},
+	"v5uru": {"v5"},
+	"vk":    {"vk"},
+	"vs-i":  {"vs-i"},
+	"vs-s":  {"vs-s"},
+	"vz":    {"vz"},
+}
+
+// EPWING books only label the broad conjugation class of a verb, so
+// the special classes of the extended rule set are looked up by
+// expression instead.
+var extendedVerbRuleTable = map[string]string{
+	"行く":     "v5k-s",
+	"往く":     "v5k-s",
+	"逝く":     "v5k-s",
+	"いく":     "v5k-s",
+	"ゆく":     "v5k-s",
+	"有る":     "v5r-i",
+	"在る":     "v5r-i",
+	"ある":     "v5r-i",
+	"下さる":    "v5aru",
+	"くださる":   "v5aru",
+	"為さる":    "v5aru",
+	"なさる":    "v5aru",
+	"仰る":     "v5aru",
+	"仰有る":    "v5aru",
+	"おっしゃる":  "v5aru",
+	"いらっしゃる": "v5aru",
+	"御座る":    "v5aru",
+	"ござる":    "v5aru",
+	"呉れる":    "v1-s",
+	"くれる":    "v1-s",
+	"問う":     "v5u-s",
+	"請う":     "v5u-s",
+	"乞う":     "v5u-s",
+	"恋う":     "v5u-s",
+}
+
+func isValidRuleSet(ruleSet string) bool {
+	switch ruleSet {
+	case "", legacyRuleSet, extendedRuleSet:
+		return true
+	default:
+		return false
+	}
+}
+
+func grammarRules(partsOfSpeech []string, ruleSet string) []string {
+	if ruleSet == extendedRuleSet {
+		rules := []string{}
+		for _, partOfSpeech := range partsOfSpeech {
+			rules = appendStringUnique(rules, extendedRuleTable[partOfSpeech]...)
+		}
+		return rules
+	}
+
+	rules := []string{}
+	for _, partOfSpeech := range partsOfSpeech {
+		switch partOfSpeech {
+		case "adj-i", "vk", "vz":
+			rules = append(rules, partOfSpeech)
+		default:
+			if strings.HasPrefix(partOfSpeech, "v5") {
+				rules = append(rules, "v5")
+			} else if strings.HasPrefix(partOfSpeech, "v1") {
+				rules = append(rules, "v1")
+			} else if strings.HasPrefix(partOfSpeech, "vs-") {
+				rules = append(rules, "vs")
+			}
+		}
+	}
+	return rules
+}
+
+// Converts the broad rules assigned by the EPWING extractors into
+// their extended counterparts.
+func extendedTermRules(expression string, rules []string) []string {
+	extendedRules := []string{}
+	for _, rule := range rules {
+		if special, ok := extendedVerbRuleTable[expression]; ok && strings.HasPrefix(special, rule) {
+			rule = special
+		} else if rule == "vs" && (strings.HasSuffix(expression, "する") || strings.HasSuffix(expression, "為る")) {
+			// EPWING extractors only assign "vs" to サ変 verbs
+			// written with する, which conjugate as irregular
+			// suru verbs; other expressions keep the broad rule.
+			rule = "vs-i"
+		}
+		extendedRules = appendStringUnique(extendedRules, rule)
+	}
+	return extendedRules
+}
@@ -0,0 +1,105 @@
+package yomitan
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGrammarRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		partsOfSpeech []string
+		wantLegacy    []string
+		wantExtended  []string
+	}{
+		{
+			name:          "godan",
+			partsOfSpeech: []string{"v5u", "vt"},
+			wantLegacy:    []string{"v5"},
+			wantExtended:  []string{"v5"},
+		},
+		{
+			name:          "special godan",
+			partsOfSpeech: []string{"v5k-s"},
+			wantLegacy:    []string{"v5"},
+			wantExtended:  []string{"v5k-s"},
+		},
+		{
+			name:          "kureru",
+			partsOfSpeech: []string{"v1-s"},
+			wantLegacy:    []string{"v1"},
+			wantExtended:  []string{"v1-s"},
+		},
+		{
+			name:          "suru verb",
+			partsOfSpeech: []string{"vs-i"},
+			wantLegacy:    []string{"vs"},
+			wantExtended:  []string{"vs-i"},
+		},
+		{
+			name:          "noun taking suru",
+			partsOfSpeech: []string{"n", "vs"},
+			wantLegacy:    []string{},
+			wantExtended:  []string{},
+		},
+		{
+			name:          "adjective",
+			partsOfSpeech: []string{"adj-i"},
+			wantLegacy:    []string{"adj-i"},
+			wantExtended:  []string{"adj-i"},
+		},
+		{
+			name:          "yoi/ii",
+			partsOfSpeech: []string{"adj-ix"},
+			wantLegacy:    []string{},
+			wantExtended:  []string{},
+		},
+		{
+			name:          "several tags",
+			partsOfSpeech: []string{"v5r", "v5r-i", "vk"},
+			wantLegacy:    []string{"v5", "v5", "vk"},
+			wantExtended:  []string{"v5", "v5r-i", "vk"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := grammarRules(test.partsOfSpeech, legacyRuleSet); !reflect.DeepEqual(got, test.wantLegacy) {
+				t.Errorf("legacy rules = %v, want %v", got, test.wantLegacy)
+			}
+			if got := grammarRules(test.partsOfSpeech, ""); !reflect.DeepEqual(got, test.wantLegacy) {
+				t.Errorf("default rules = %v, want %v", got, test.wantLegacy)
+			}
+			if got := grammarRules(test.partsOfSpeech, extendedRuleSet); !reflect.DeepEqual(got, test.wantExtended) {
+				t.Errorf("extended rules = %v, want %v", got, test.wantExtended)
+			}
+		})
+	}
+}
+
+func TestExtendedTermRules(t *testing.T) {
+	tests := []struct {
+		expression string
+		rules      []string
+		want       []string
+	}{
+		{"行く", []string{"v5"}, []string{"v5k-s"}},
+		{"いく", []string{"v5"}, []string{"v5k-s"}},
+		{"くださる", []string{"v5"}, []string{"v5aru"}},
+		{"下さる", []string{"v5"}, []string{"v5aru"}},
+		{"くれる", []string{"v1"}, []string{"v1-s"}},
+		{"書く", []string{"v5"}, []string{"v5"}},
+		{"行く", []string{"adj-i"}, []string{"adj-i"}},
+		{"勉強する", []string{"vs"}, []string{"vs-i"}},
+		{"愛為る", []string{"vs"}, []string{"vs-i"}},
+		{"勉強", []string{"vs"}, []string{"vs"}},
+		{"来る", []string{"vk"}, []string{"vk"}},
+		{"会う", nil, []string{}},
+	}
+
+	for _, test := range tests {
+		if got := extendedTermRules(test.expression, test.rules); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("extendedTermRules(%q, %v) = %v, want %v", test.expression, test.rules, got, test.want)
+		}
+	}
+}
@@ -5,46 +5,11 @@ import (
 	"os"
 	"regexp"
 	"strconv"
-	"strings"
 
 	jmdict "github.com/themoeway/jmdict-go"
 	"golang.org/x/exp/slices"
 )
 
-func grammarRules(partsOfSpeech []string) []string {
-	rules := []string{}
-	for _, partOfSpeech := range partsOfSpeech {
-		switch partOfSpeech {
-		case "adj-i", "vk", "vz":
-			rules = append(rules, partOfSpeech)
-		default:
-			if strings.HasPrefix(partOfSpeech, "v5") {
-				rules = append(rules, "v5")
-			} else if strings.HasPrefix(partOfSpeech, "v1") {
-				rules = append(rules, "v1")
-			} else if strings.HasPrefix(partOfSpeech, "vs-") {
-				rules = append(rules, "vs")
-			}
-		}
-	}
-	return rules
-}
-
-func calculateTermScore(senseNumber int, depth int, headword headword) int {
-	const senseWeight int = 1
-	const depthWeight int = 100
-	const entryPositionWeight int = 10000
-	const priorityWeight int = 1000000
-
-	score := 0
-	score -= (senseNumber - 1) * senseWeight
-	score -= depth * depthWeight
-	score -= headword.Index * entryPositionWeight
-	score += headword.Score() * priorityWeight
-
-	return score
-}
-
 func doDisplaySenseNumberTag(headword headword, entry jmdict.JmdictEntry, meta jmdictMetadata) bool {
 	// Display sense numbers if the entry has more than one sense
 	// or if the headword is found in multiple entries.
@@ -112,7 +77,7 @@ func jmdictFormsTerm(headword headword, entry jmdict.JmdictEntry, meta jmdictMet
 
 	senseNumber := meta.seqToSenseCount[entry.Sequence] + 1
 	entryDepth := meta.entryDepth[entry.Sequence]
-	term.Score = calculateTermScore(senseNumber, entryDepth, headword)
+	term.Score = calculateTermScore(senseNumber, entryDepth, headword, term, meta.scoring)
 
 	return term, true
 }
@@ -131,11 +96,11 @@ func jmdictSearchTerm(headword headword, entry jmdict.JmdictEntry, meta jmdictMe
 	}
 
 	partsOfSpeech := meta.seqToPartsOfSpeech[entry.Sequence]
-	rules := grammarRules(partsOfSpeech)
+	rules := grammarRules(partsOfSpeech, meta.ruleSet)
 	term.addRules(rules...)
 
 	term.addTermTags(headword.TermTags...)
-	term.Score = calculateTermScore(1, 0, headword)
+	term.Score = calculateTermScore(1, 0, headword, term, meta.scoring)
 
 	redirectHeadword := meta.seqToMainHeadword[entry.Sequence]
 	expHash := redirectHeadword.ExpHash()
@@ -151,11 +116,18 @@ func jmdictSearchTerm(headword headword, entry jmdict.JmdictEntry, meta jmdictMe
 	return term, true
 }
 
-func jmdictSenseTerm(sense jmdict.JmdictSense, senseNumber int, headword headword, entry jmdict.JmdictEntry, meta jmdictMetadata) (dbTerm, bool) {
+func senseAppliesToHeadword(sense jmdict.JmdictSense, headword headword) bool {
 	if sense.RestrictedReadings != nil && !slices.Contains(sense.RestrictedReadings, headword.Reading) {
-		return dbTerm{}, false
+		return false
 	}
 	if sense.RestrictedKanji != nil && !slices.Contains(sense.RestrictedKanji, headword.Expression) {
+		return false
+	}
+	return true
+}
+
+func jmdictSenseTerm(sense jmdict.JmdictSense, senseNumber int, headword headword, entry jmdict.JmdictEntry, meta jmdictMetadata) (dbTerm, bool) {
+	if !senseAppliesToHeadword(sense, headword) {
 		return dbTerm{}, false
 	}
 
@@ -185,11 +157,54 @@ func jmdictSenseTerm(sense jmdict.JmdictSense, senseNumber int, headword headwor
 	term.addDefinitionTags(sense.Misc...)
 	term.addDefinitionTags(sense.Dialects...)
 
-	rules := grammarRules(sense.PartsOfSpeech)
+	rules := grammarRules(sense.PartsOfSpeech, meta.ruleSet)
 	term.addRules(rules...)
 
 	entryDepth := meta.entryDepth[entry.Sequence]
-	term.Score = calculateTermScore(senseNumber, entryDepth, headword)
+	term.Score = calculateTermScore(senseNumber, entryDepth, headword, term, meta.scoring)
+
+	return term, true
+}
+
+func jmdictEntryTerm(headword headword, entry jmdict.JmdictEntry, meta jmdictMetadata) (dbTerm, bool) {
+	term := dbTerm{
+		Expression: headword.Expression,
+		Reading:    headword.Reading,
+		Sequence:   entry.Sequence,
+	}
+
+	senses := []jmdict.JmdictSense{}
+	for _, sense := range entry.Sense {
+		if !glossaryContainsLanguage(sense.Glossary, meta.language) {
+			continue
+		}
+		if !senseAppliesToHeadword(sense, headword) {
+			continue
+		}
+		if len(sense.PartsOfSpeech) == 0 && meta.language != "eng" {
+			// Same hack as in jmdictSenseTerm.
+			sense.PartsOfSpeech = meta.seqToPartsOfSpeech[entry.Sequence]
+		}
+
+		term.addDefinitionTags(sense.PartsOfSpeech...)
+		term.addDefinitionTags(sense.Fields...)
+		term.addDefinitionTags(sense.Misc...)
+		term.addDefinitionTags(sense.Dialects...)
+
+		rules := grammarRules(sense.PartsOfSpeech, meta.ruleSet)
+		term.addRules(rules...)
+
+		senses = append(senses, sense)
+	}
+	if len(senses) == 0 {
+		return dbTerm{}, false
+	}
+
+	term.Glossary = createEntryGlossary(senses, meta)
+	term.addTermTags(headword.TermTags...)
+
+	entryDepth := meta.entryDepth[entry.Sequence]
+	term.Score = calculateTermScore(1, entryDepth, headword, term, meta.scoring)
 
 	return term, true
 }
@@ -206,16 +221,22 @@ func jmdictTerms(headword headword, entry jmdict.JmdictEntry, meta jmdictMetadat
 		}
 	}
 	terms := []dbTerm{}
-	senseNumber := 1
-	for _, sense := range entry.Sense {
-		if !glossaryContainsLanguage(sense.Glossary, meta.language) {
-			// Do not increment sense number
-			continue
+	if meta.entryMode {
+		if entryTerm, ok := jmdictEntryTerm(headword, entry, meta); ok {
+			terms = append(terms, entryTerm)
 		}
-		if senseTerm, ok := jmdictSenseTerm(sense, senseNumber, headword, entry, meta); ok {
-			terms = append(terms, senseTerm)
+	} else {
+		senseNumber := 1
+		for _, sense := range entry.Sense {
+			if !glossaryContainsLanguage(sense.Glossary, meta.language) {
+				// Do not increment sense number
+				continue
+			}
+			if senseTerm, ok := jmdictSenseTerm(sense, senseNumber, headword, entry, meta); ok {
+				terms = append(terms, senseTerm)
+			}
+			senseNumber += 1
 		}
-		senseNumber += 1
 	}
 
 	if formsTerm, ok := jmdictFormsTerm(headword, entry, meta); ok {
@@ -225,23 +246,32 @@ func jmdictTerms(headword headword, entry jmdict.JmdictEntry, meta jmdictMetadat
 	return terms, true
 }
 
-func jmdictExportDb(inputPath string, outputPath string, languageName string, title string, stride int, pretty bool) error {
-	if _, ok := langNameToCode[languageName]; !ok {
-		return errors.New("Unrecognized language parameter: " + languageName)
-	}
-
+func jmdictLoad(inputPath string) (jmdict.Jmdict, map[string]string, error) {
 	reader, err := os.Open(inputPath)
 	if err != nil {
-		return err
+		return jmdict.Jmdict{}, nil, err
 	}
 	defer reader.Close()
 
-	dictionary, entities, err := jmdict.LoadJmdictNoTransform(reader)
+	return jmdict.LoadJmdictNoTransform(reader)
+}
+
+func jmdictExportDb(inputPath string, outputPath string, languageName string, title string, stride int, pretty bool, options ExportOptions) error {
+	if _, ok := langNameToCode[languageName]; !ok {
+		return errors.New("Unrecognized language parameter: " + languageName)
+	}
+
+	dictionary, entities, err := jmdictLoad(inputPath)
 	if err != nil {
 		return err
 	}
 
-	meta := newJmdictMetadata(dictionary, languageName)
+	return jmdictWriteDb(dictionary, entities, outputPath, languageName, title, stride, pretty, options)
+}
+
+func jmdictWriteDb(dictionary jmdict.Jmdict, entities map[string]string, outputPath string, languageName string, title string, stride int, pretty bool, options ExportOptions) error {
+	meta := newJmdictMetadata(dictionary, languageName, options)
+	meta.entityNotes = entities
 
 	terms := dbTermList{}
 	for _, entry := range dictionary.Entries {
@@ -253,6 +283,10 @@ func jmdictExportDb(inputPath string, outputPath string, languageName string, ti
 		}
 	}
 
+	if options.ScoreStats {
+		printScoreStats(outputPath, terms, meta.scoring)
+	}
+
 	tags := dbTagList{}
 	tags = append(tags, entityTags(entities)...)
 	tags = append(tags, senseNumberTags(meta.maxSenseCount)...)
@@ -0,0 +1,109 @@
+package yomitan
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+const formsVariant = "forms"
+
+var jmdictBatchDefaultVariants = []string{
+	"english_extra",
+	"english",
+	"dutch",
+	"french",
+	"german",
+	"hungarian",
+	"russian",
+	"slovenian",
+	"spanish",
+	"swedish",
+	formsVariant,
+}
+
+func jmdictBatchVariants(variantList string) ([]string, error) {
+	if variantList == "" {
+		return jmdictBatchDefaultVariants, nil
+	}
+	variants := []string{}
+	for _, variant := range strings.Split(variantList, ",") {
+		variant = strings.TrimSpace(variant)
+		if variant == "" {
+			continue
+		}
+		if _, ok := langNameToCode[variant]; !ok && variant != formsVariant {
+			return nil, errors.New("Unrecognized language parameter: " + variant)
+		}
+		variants = appendStringUnique(variants, variant)
+	}
+	return variants, nil
+}
+
+func jmdictBatchTitle(variant string, title string) string {
+	switch variant {
+	case formsVariant:
+		return title + " Forms"
+	case "english_extra":
+		return title
+	default:
+		return title + " (" + strings.ToUpper(variant[:1]) + variant[1:] + ")"
+	}
+}
+
+// Builds every requested JMdict language variant (and optionally the
+// forms dictionary) from a single parse of the JMdict file. The
+// language parameter is a comma-separated list of variants and the
+// output path is a directory which receives one archive per variant.
+func jmdictBatchExportDb(inputPath, outputDir, variantList, title string, stride int, pretty bool, options ExportOptions) error {
+	variants, err := jmdictBatchVariants(variantList)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+
+	dictionary, entities, err := jmdictLoad(inputPath)
+	if err != nil {
+		return err
+	}
+
+	if title == "" {
+		title = "JMdict"
+	}
+
+	// Each variant holds its full term list and archive in memory, so
+	// only as many variants as there are processors are built at once.
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, runtime.GOMAXPROCS(0))
+	errs := make([]error, len(variants))
+	for i, variant := range variants {
+		wg.Add(1)
+		go func(i int, variant string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			outputPath := filepath.Join(outputDir, "jmdict_"+variant+".zip")
+			variantTitle := jmdictBatchTitle(variant, title)
+			if variant == formsVariant {
+				errs[i] = formsWriteDb(dictionary, entities, outputPath, variantTitle, stride, pretty, options)
+			} else {
+				errs[i] = jmdictWriteDb(dictionary, entities, outputPath, variant, variantTitle, stride, pretty, options)
+			}
+		}(i, variant)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -70,6 +70,39 @@ var refNoteHint = map[LangCode]string{
 	LangCode{"eng", "ant"}:  "antonym",
 }
 
+var nameNoteHint = map[LangCode]string{
+	LangCode{"eng", "readings"}: "other readings",
+}
+
+var nameTypeCodeToName = map[LangCode]string{
+	LangCode{"eng", "char"}:         "Character",
+	LangCode{"eng", "company"}:      "Company",
+	LangCode{"eng", "creat"}:        "Creature",
+	LangCode{"eng", "dei"}:          "Deity",
+	LangCode{"eng", "doc"}:          "Document",
+	LangCode{"eng", "ev"}:           "Event",
+	LangCode{"eng", "fem"}:          "Female given name",
+	LangCode{"eng", "fict"}:         "Fiction",
+	LangCode{"eng", "given"}:        "Given name",
+	LangCode{"eng", "group"}:        "Group",
+	LangCode{"eng", "leg"}:          "Legend",
+	LangCode{"eng", "masc"}:         "Male given name",
+	LangCode{"eng", "myth"}:         "Mythology",
+	LangCode{"eng", "obj"}:          "Object",
+	LangCode{"eng", "organization"}: "Organization",
+	LangCode{"eng", "oth"}:          "Other",
+	LangCode{"eng", "person"}:       "Full name",
+	LangCode{"eng", "place"}:        "Place name",
+	LangCode{"eng", "product"}:      "Product",
+	LangCode{"eng", "relig"}:        "Religion",
+	LangCode{"eng", "serv"}:         "Service",
+	LangCode{"eng", "ship"}:         "Ship",
+	LangCode{"eng", "station"}:      "Railway station",
+	LangCode{"eng", "surname"}:      "Surname",
+	LangCode{"eng", "unclass"}:      "Unclassified name",
+	LangCode{"eng", "work"}:         "Work",
+}
+
 var sourceLangTypeCodeToType = map[LangCode]string{
 	LangCode{"eng", "part"}: "partial",
 	LangCode{"eng", ""}:     "", // implied "full"
@@ -1,7 +1,7 @@
 package yomitan
 
 import (
-	"os"
+	"strconv"
 	"strings"
 
 	jmdict "github.com/themoeway/jmdict-go"
@@ -90,21 +90,74 @@ func needsFormTable(headwords []headword) bool {
 	return false
 }
 
+// Returns the numbers of the senses which apply to the headword,
+// numbered in the same way as the sense terms of the entry.
+func headwordSenseNumbers(h headword, entry jmdict.JmdictEntry, meta jmdictMetadata) []int {
+	senseNumbers := []int{}
+	senseNumber := 1
+	for _, sense := range entry.Sense {
+		if !glossaryContainsLanguage(sense.Glossary, meta.language) {
+			continue
+		}
+		if senseAppliesToHeadword(sense, h) {
+			senseNumbers = append(senseNumbers, senseNumber)
+		}
+		senseNumber += 1
+	}
+	return senseNumbers
+}
+
+func hasSenseRestrictions(entry jmdict.JmdictEntry, meta jmdictMetadata) bool {
+	for _, sense := range entry.Sense {
+		if !glossaryContainsLanguage(sense.Glossary, meta.language) {
+			continue
+		}
+		if sense.RestrictedKanji != nil || sense.RestrictedReadings != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Sense numbers are only worth displaying when some of the senses
+// in the entry are restricted to particular kanji forms or readings.
+// Returns nil otherwise.
+func formsSenseText(headwords []headword, entry jmdict.JmdictEntry, meta jmdictMetadata) map[hash]string {
+	if !hasSenseRestrictions(entry, meta) {
+		return nil
+	}
+	senseText := make(map[hash]string)
+	for _, h := range headwords {
+		numbers := []string{}
+		for _, senseNumber := range headwordSenseNumbers(h, entry, meta) {
+			numbers = append(numbers, strconv.Itoa(senseNumber))
+		}
+		// Headwords without senses of the current language are
+		// left unannotated rather than marked with an empty list.
+		if len(numbers) > 0 {
+			senseText[h.Hash()] = strings.Join(numbers, ",")
+		}
+	}
+	return senseText
+}
+
 type formTableData struct {
 	kanjiForms    []string
 	readings      []string
 	colHeaderText map[string]string
 	rowHeaderText map[string]string
 	cellText      map[string]map[string]string
+	cellSenseText map[string]map[string]string
 }
 
-func tableData(headwords []headword) formTableData {
+func tableData(headwords []headword, senseText map[hash]string) formTableData {
 	d := formTableData{
 		kanjiForms:    []string{},
 		readings:      []string{},
 		colHeaderText: make(map[string]string),
 		rowHeaderText: make(map[string]string),
 		cellText:      make(map[string]map[string]string),
+		cellSenseText: make(map[string]map[string]string),
 	}
 	for _, h := range headwords {
 		if h.IsSearchOnly {
@@ -120,14 +173,27 @@ func tableData(headwords []headword) formTableData {
 			d.readings = append(d.readings, reading)
 			d.rowHeaderText[reading] = h.TableRowHeaderText()
 			d.cellText[reading] = make(map[string]string)
+			d.cellSenseText[reading] = make(map[string]string)
 		}
 		d.cellText[reading][kanjiForm] = h.TableCellText()
+		if text, ok := senseText[h.Hash()]; ok {
+			d.cellSenseText[reading][kanjiForm] = text
+		}
 	}
 	return d
 }
 
-func formsTableGlossary(headwords []headword) []any {
-	d := tableData(headwords)
+func formsSenseSpan(text string) any {
+	attr := contentAttr{
+		fontSize:      "65%",
+		verticalAlign: "super",
+		data:          map[string]string{"content": "formSenses"},
+	}
+	return contentSpan(attr, text)
+}
+
+func formsTableGlossary(headwords []headword, senseText map[hash]string) []any {
+	d := tableData(headwords, senseText)
 
 	attr := contentAttr{}
 	centeredAttr := contentAttr{textAlign: "center"}
@@ -149,6 +215,9 @@ func formsTableGlossary(headwords []headword) []any {
 		for _, kanjiForm := range d.kanjiForms {
 			text := d.cellText[reading][kanjiForm]
 			rowCell := contentTableCell(centeredAttr, text)
+			if senses, ok := d.cellSenseText[reading][kanjiForm]; ok {
+				rowCell = contentTableCell(centeredAttr, text, formsSenseSpan(senses))
+			}
 			rowCells = append(rowCells, rowCell)
 		}
 		tableRow := contentTableRow(attr, rowCells...)
@@ -160,13 +229,16 @@ func formsTableGlossary(headwords []headword) []any {
 	return []any{content}
 }
 
-func formsGlossary(headwords []headword) []any {
+func formsGlossary(headwords []headword, senseText map[hash]string) []any {
 	glossary := []any{}
 	for _, h := range headwords {
 		if h.IsSearchOnly {
 			continue
 		}
 		text := h.GlossText()
+		if senses, ok := senseText[h.Hash()]; ok {
+			text += "［" + senses + "］"
+		}
 		glossary = append(glossary, text)
 	}
 	return glossary
@@ -175,33 +247,32 @@ func formsGlossary(headwords []headword) []any {
 func baseFormsTerm(entry jmdict.JmdictEntry, meta jmdictMetadata) dbTerm {
 	term := dbTerm{Sequence: entry.Sequence}
 	headwords := extractHeadwords(entry)
+	senseText := formsSenseText(headwords, entry, meta)
 
 	if needsFormTable(headwords) {
-		term.Glossary = formsTableGlossary(headwords)
+		term.Glossary = formsTableGlossary(headwords, senseText)
 	} else {
-		term.Glossary = formsGlossary(headwords)
+		term.Glossary = formsGlossary(headwords, senseText)
 	}
 
 	partsOfSpeech := meta.seqToPartsOfSpeech[entry.Sequence]
-	rules := grammarRules(partsOfSpeech)
+	rules := grammarRules(partsOfSpeech, meta.ruleSet)
 	term.addRules(rules...)
 
 	return term
 }
 
-func formsExportDb(inputPath, outputPath, languageName, title string, stride int, pretty bool) error {
-	reader, err := os.Open(inputPath)
+func formsExportDb(inputPath, outputPath, languageName, title string, stride int, pretty bool, options ExportOptions) error {
+	dictionary, entities, err := jmdictLoad(inputPath)
 	if err != nil {
 		return err
 	}
-	defer reader.Close()
 
-	dictionary, entities, err := jmdict.LoadJmdictNoTransform(reader)
-	if err != nil {
-		return err
-	}
+	return formsWriteDb(dictionary, entities, outputPath, title, stride, pretty, options)
+}
 
-	meta := newJmdictMetadata(dictionary, "")
+func formsWriteDb(dictionary jmdict.Jmdict, entities map[string]string, outputPath, title string, stride int, pretty bool, options ExportOptions) error {
+	meta := newJmdictMetadata(dictionary, "", options)
 
 	terms := dbTermList{}
 	for _, entry := range dictionary.Entries {
@@ -218,11 +289,15 @@ func formsExportDb(inputPath, outputPath, languageName, title string, stride int
 			term.Expression = h.Expression
 			term.Reading = h.Reading
 			term.addTermTags(h.TermTags...)
-			term.Score = calculateTermScore(1, 0, h)
+			term.Score = calculateTermScore(1, 0, h, term, meta.scoring)
 			terms = append(terms, term)
 		}
 	}
 
+	if options.ScoreStats {
+		printScoreStats(outputPath, terms, meta.scoring)
+	}
+
 	tags := dbTagList{}
 	tags = append(tags, entityTags(entities)...)
 	tags = append(tags, newsFrequencyTags()...)
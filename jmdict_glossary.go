@@ -3,6 +3,7 @@ package yomitan
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	jmdict "github.com/themoeway/jmdict-go"
 )
@@ -190,6 +191,10 @@ func needsStructuredContent(sense jmdict.JmdictSense, language string) bool {
 }
 
 func createGlossaryContent(sense jmdict.JmdictSense, meta jmdictMetadata) any {
+	return contentStructure(createGlossaryContents(sense, meta)...)
+}
+
+func createGlossaryContents(sense jmdict.JmdictSense, meta jmdictMetadata) []any {
 	glossaryContents := []any{}
 
 	// Add normal glosses
@@ -282,7 +287,7 @@ func createGlossaryContent(sense jmdict.JmdictSense, meta jmdictMetadata) any {
 		glossaryContents = append(glossaryContents, list)
 	}
 
-	return contentStructure(glossaryContents...)
+	return glossaryContents
 }
 
 func createGlossary(sense jmdict.JmdictSense, meta jmdictMetadata) []any {
@@ -298,3 +303,61 @@ func createGlossary(sense jmdict.JmdictSense, meta jmdictMetadata) []any {
 	}
 	return glossary
 }
+
+// Returns the descriptions of the tags of a sense, e.g. "noun (common)
+// (futsuumeishi)" rather than the entity name "n". Entities without a
+// description are listed by name.
+func senseTagList(sense jmdict.JmdictSense, entityNotes map[string]string) []string {
+	names := []string{}
+	names = appendStringUnique(names, sense.PartsOfSpeech...)
+	names = appendStringUnique(names, sense.Fields...)
+	names = appendStringUnique(names, sense.Misc...)
+	names = appendStringUnique(names, sense.Dialects...)
+
+	tags := []string{}
+	for _, name := range names {
+		if notes, ok := entityNotes[name]; ok && notes != "" {
+			tags = append(tags, notes)
+		} else {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+func createEntryGlossary(senses []jmdict.JmdictSense, meta jmdictMetadata) []any {
+	senseListItems := []any{}
+	for _, sense := range senses {
+		contents := []any{}
+
+		// Tags are displayed inline since the definition tags of
+		// the term are shared by every sense.
+		if tags := senseTagList(sense, meta.entityNotes); len(tags) > 0 {
+			attr := contentAttr{
+				fontStyle: "italic",
+				data:      map[string]string{"content": "senseTags"},
+			}
+			contents = append(contents, contentSpan(attr, "("+strings.Join(tags, "; ")+")"))
+		}
+
+		if meta.extraMode {
+			contents = append(contents, createGlossaryContents(sense, meta)...)
+		} else {
+			glossListItems := []any{}
+			for _, gloss := range sense.Glossary {
+				if glossContainsLanguage(gloss, meta.language) {
+					listItem := makeGlossListItem(gloss, meta.language)
+					glossListItems = append(glossListItems, listItem)
+				}
+			}
+			attr := listAttr(ISOtoHTML[meta.language], "circle", "glossary")
+			contents = append(contents, contentUnorderedList(attr, glossListItems...))
+		}
+
+		listItem := contentListItem(contentAttr{data: map[string]string{"content": "sense"}}, contents...)
+		senseListItems = append(senseListItems, listItem)
+	}
+
+	attr := listAttr(ISOtoHTML[meta.language], "", "senses")
+	return []any{contentStructure(contentOrderedList(attr, senseListItems...))}
+}
@@ -66,24 +66,34 @@ func (h *headword) IsKanaOnly() bool {
 }
 
 func (h *headword) Score() int {
+	return h.FlagScore(defaultHeadwordFlagWeights())
+}
+
+func (h *headword) FlagScore(weights map[string]int) int {
 	score := 0
 	if h.IsPriority {
-		score += 1
+		score += weights["priority"]
 	}
 	if h.IsFrequent {
-		score += 1
+		score += weights["frequent"]
 	}
 	if h.IsIrregular {
-		score -= 5
+		score += weights["irregular"]
 	}
 	if h.IsOutdated {
-		score -= 5
+		score += weights["outdated"]
 	}
 	if h.IsRareKanji {
-		score -= 5
+		score += weights["rareKanji"]
 	}
 	if h.IsSearchOnly {
-		score -= 5
+		score += weights["searchOnly"]
+	}
+	if h.IsAteji {
+		score += weights["ateji"]
+	}
+	if h.IsGikun {
+		score += weights["gikun"]
 	}
 	return score
 }
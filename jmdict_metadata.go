@@ -25,6 +25,10 @@ type jmdictMetadata struct {
 	hasMultipleForms   map[sequence]bool
 	maxSenseCount      int
 	extraMode          bool
+	ruleSet            string
+	entryMode          bool
+	scoring            scoringProfile
+	entityNotes        map[string]string
 }
 
 type senseID struct {
@@ -132,7 +136,7 @@ func (meta *jmdictMetadata) AddHeadword(headword headword, seq sequence) {
 	}
 }
 
-func newJmdictMetadata(dictionary jmdict.Jmdict, languageName string) jmdictMetadata {
+func newJmdictMetadata(dictionary jmdict.Jmdict, languageName string, options ExportOptions) jmdictMetadata {
 	meta := jmdictMetadata{
 		language:           langNameToCode[languageName],
 		seqToSenseCount:    make(map[sequence]int),
@@ -149,6 +153,9 @@ func newJmdictMetadata(dictionary jmdict.Jmdict, languageName string) jmdictMeta
 		hasMultipleForms:   make(map[sequence]bool),
 		maxSenseCount:      0,
 		extraMode:          languageName == "english_extra",
+		ruleSet:            options.RuleSet,
+		entryMode:          options.EntryMode,
+		scoring:            options.scoringProfile(),
 	}
 
 	for _, entry := range dictionary.Entries {
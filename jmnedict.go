@@ -2,6 +2,7 @@ package yomitan
 
 import (
 	"os"
+	"path/filepath"
 	"regexp"
 
 	jmdict "github.com/themoeway/jmdict-go"
@@ -27,7 +28,40 @@ func jmnedictPublicationDate(dictionary jmdict.Jmnedict) string {
 	}
 }
 
-func jmnedictSenseTerm(headword headword, seq sequence, sense jmdict.JmnedictTranslation, senseNumber int) dbTerm {
+type jmnedictMetadata struct {
+	language             string
+	extraMode            bool
+	scoring              scoringProfile
+	expressionToReadings map[string][]string
+}
+
+func newJmnedictMetadata(entries []jmdict.JmnedictEntry, languageName string, options ExportOptions) jmnedictMetadata {
+	meta := jmnedictMetadata{
+		language:             langNameToCode[languageName],
+		extraMode:            languageName == "english_extra",
+		scoring:              options.scoringProfile(),
+		expressionToReadings: make(map[string][]string),
+	}
+	if meta.language == "" {
+		meta.language = "eng"
+	}
+
+	if meta.extraMode {
+		for _, entry := range entries {
+			for _, headword := range jmnedictHeadwords(entry) {
+				if headword.Expression == headword.Reading {
+					continue
+				}
+				meta.expressionToReadings[headword.Expression] =
+					appendStringUnique(meta.expressionToReadings[headword.Expression], headword.Reading)
+			}
+		}
+	}
+
+	return meta
+}
+
+func jmnedictSenseTerm(headword headword, seq sequence, sense jmdict.JmnedictTranslation, senseNumber int, profile scoringProfile) dbTerm {
 	term := dbTerm{
 		Expression: headword.Expression,
 		Reading:    headword.Reading,
@@ -37,21 +71,43 @@ func jmnedictSenseTerm(headword headword, seq sequence, sense jmdict.JmnedictTra
 		term.Glossary = append(term.Glossary, gloss)
 	}
 	term.addDefinitionTags(sense.NameTypes...)
-	term.Score = calculateTermScore(senseNumber, 0, headword)
+	term.Score = calculateTermScore(senseNumber, 0, headword, term, profile)
 	return term
 }
 
-func jmnedictTerms(headword headword, entry jmdict.JmnedictEntry, g genericTermInfo) []dbTerm {
+func jmnedictStructuredTerm(headword headword, seq sequence, senses []jmdict.JmnedictTranslation, meta jmnedictMetadata) dbTerm {
+	term := dbTerm{
+		Expression: headword.Expression,
+		Reading:    headword.Reading,
+		Sequence:   seq,
+	}
+	for _, sense := range senses {
+		term.addDefinitionTags(sense.NameTypes...)
+	}
+	term.Glossary = createNameGlossary(headword, senses, meta)
+	term.Score = calculateTermScore(1, 0, headword, term, meta.scoring)
+	return term
+}
+
+func jmnedictTerms(headword headword, entry jmdict.JmnedictEntry, g *genericTermInfo, meta jmnedictMetadata) []dbTerm {
 	terms := []dbTerm{}
+	structuredSenses := []jmdict.JmnedictTranslation{}
 	for idx, sense := range entry.Translations {
 		if g.IsGenericName(headword, sense.Translations) {
-			g.AddGlosses(headword.Expression, sense.NameTypes, headword.Reading)
+			g.AddReading(headword.Expression, sense.NameTypes, headword.Reading)
+		} else if meta.extraMode {
+			g.AddUsedSequence(entry.Sequence)
+			structuredSenses = append(structuredSenses, sense)
 		} else {
 			g.AddUsedSequence(entry.Sequence)
-			senseTerm := jmnedictSenseTerm(headword, entry.Sequence, sense, idx+1)
+			senseTerm := jmnedictSenseTerm(headword, entry.Sequence, sense, idx+1, meta.scoring)
 			terms = append(terms, senseTerm)
 		}
 	}
+	if len(structuredSenses) > 0 {
+		structuredTerm := jmnedictStructuredTerm(headword, entry.Sequence, structuredSenses, meta)
+		terms = append(terms, structuredTerm)
+	}
 	return terms
 }
 
@@ -81,7 +137,12 @@ func jmnedictHeadwords(entry jmdict.JmnedictEntry) (headwords []headword) {
 	return headwords
 }
 
-func jmnedictExportDb(inputPath, outputPath, language, title string, stride int, pretty bool) error {
+func jmnedictExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	subsets, err := parseJmnedictSubsets(options.NameTypes, options.SplitNameTypes)
+	if err != nil {
+		return err
+	}
+
 	reader, err := os.Open(inputPath)
 	if err != nil {
 		return err
@@ -93,37 +154,86 @@ func jmnedictExportDb(inputPath, outputPath, language, title string, stride int,
 		return err
 	}
 
-	genericTermInfo := newGenericTermInfo()
+	tags := entityTags(entities)
+
+	if title == "" {
+		title = "JMnedict"
+	}
+
+	if subsets == nil {
+		meta := newJmnedictMetadata(dictionary.Entries, language, options)
+		genericTermInfo := newGenericTermInfo()
+		terms := dbTermList{}
+		for _, entry := range dictionary.Entries {
+			headwords := jmnedictHeadwords(entry)
+			for _, headword := range headwords {
+				newTerms := jmnedictTerms(headword, entry, &genericTermInfo, meta)
+				terms = append(terms, newTerms...)
+			}
+		}
+		terms = append(terms, genericTermInfo.Terms(meta)...)
+
+		index := dbIndex{Title: title}
+		return jmnedictWriteDb(dictionary, terms, tags, outputPath, index, stride, pretty, options)
+	}
 
-	terms := dbTermList{}
-	for _, entry := range dictionary.Entries {
-		headwords := jmnedictHeadwords(entry)
-		for _, headword := range headwords {
-			newTerms := jmnedictTerms(headword, entry, genericTermInfo)
-			terms = append(terms, newTerms...)
+	for _, subset := range subsets {
+		// The related readings of a subset's names only come from
+		// entries which are part of the subset.
+		entries := []jmdict.JmnedictEntry{}
+		for _, entry := range dictionary.Entries {
+			subsetEntry := subset.filterEntry(entry)
+			if len(subsetEntry.Translations) > 0 {
+				entries = append(entries, subsetEntry)
+			}
+		}
+		meta := newJmnedictMetadata(entries, language, options)
+		for _, entry := range entries {
+			for _, headword := range jmnedictHeadwords(entry) {
+				newTerms := jmnedictTerms(headword, entry, &subset.generic, meta)
+				subset.terms = append(subset.terms, newTerms...)
+			}
 		}
+		subset.terms = append(subset.terms, subset.generic.Terms(meta)...)
 	}
-	terms = append(terms, genericTermInfo.Terms()...)
 
-	tags := dbTagList{}
-	tags = append(tags, entityTags(entities)...)
+	if options.SplitNameTypes {
+		if err := os.MkdirAll(outputPath, 0755); err != nil {
+			return err
+		}
+	}
+
+	for _, subset := range subsets {
+		index := dbIndex{
+			Title:       title + " (" + subset.displayName() + ")",
+			Description: subset.description(),
+		}
+		subsetPath := outputPath
+		if options.SplitNameTypes {
+			subsetPath = filepath.Join(outputPath, subset.fileName())
+		}
+		if err := jmnedictWriteDb(dictionary, subset.terms, subset.filterTags(tags), subsetPath, index, stride, pretty, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func jmnedictWriteDb(dictionary jmdict.Jmnedict, terms dbTermList, tags dbTagList, outputPath string, index dbIndex, stride int, pretty bool, options ExportOptions) error {
+	if options.ScoreStats {
+		printScoreStats(index.Title, terms, options.scoringProfile())
+	}
 
 	recordData := map[string]dbRecordList{
 		"term": terms.crush(),
 		"tag":  tags.crush(),
 	}
 
-	if title == "" {
-		title = "JMnedict"
-	}
 	jmnedictDate := jmnedictPublicationDate(dictionary)
-
-	index := dbIndex{
-		Title:       title,
-		Revision:    "JMnedict." + jmnedictDate,
-		Sequenced:   true,
-		Attribution: edrdgAttribution,
-	}
+	index.Revision = "JMnedict." + jmnedictDate
+	index.Sequenced = true
+	index.Attribution = edrdgAttribution
 
 	return writeDb(
 		outputPath,
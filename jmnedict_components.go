@@ -0,0 +1,171 @@
+package yomitan
+
+import (
+	"os"
+	"sort"
+	"strconv"
+
+	jmdict "github.com/themoeway/jmdict-go"
+	"golang.org/x/exp/slices"
+)
+
+// Name types of the entries which contribute to the component
+// dictionary. Full names ("person") are deliberately left out.
+var nameComponentTypes = []string{"surname", "given", "masc", "fem"}
+
+type nameComponentReading struct {
+	reading   string
+	count     int
+	nameTypes []string
+}
+
+type nameComponent struct {
+	expression string
+	readings   []*nameComponentReading
+}
+
+func (c *nameComponent) count() int {
+	count := 0
+	for _, r := range c.readings {
+		count += r.count
+	}
+	return count
+}
+
+func (c *nameComponent) addReading(reading string, nameTypes []string) {
+	// Katakana readings and iteration marks are normalized so that
+	// they are counted together with their plain hiragana forms.
+	reading = replaceIterationMarks(katakanaToHiragana(reading))
+	idx := slices.IndexFunc(c.readings, func(r *nameComponentReading) bool { return r.reading == reading })
+	if idx == -1 {
+		c.readings = append(c.readings, &nameComponentReading{reading: reading})
+		idx = len(c.readings) - 1
+	}
+	c.readings[idx].count += 1
+	c.readings[idx].nameTypes = appendStringUnique(c.readings[idx].nameTypes, nameTypes...)
+}
+
+func nameComponentTerm(component *nameComponent, seq sequence, language string) dbTerm {
+	term := dbTerm{
+		Expression: component.expression,
+		Sequence:   seq,
+		Score:      component.count(),
+	}
+
+	readings := slices.Clone(component.readings)
+	sort.SliceStable(readings, func(i, j int) bool {
+		return readings[i].count > readings[j].count
+	})
+
+	listItems := []any{}
+	for _, r := range readings {
+		term.addDefinitionTags(r.nameTypes...)
+		countAttr := contentAttr{
+			fontSize: "80%",
+			data:     map[string]string{"content": "readingCount"},
+		}
+		nameTypeAttr := contentAttr{
+			lang:      ISOtoHTML[language],
+			fontStyle: "italic",
+			data:      map[string]string{"content": "nameType"},
+		}
+		listItems = append(listItems, contentListItem(
+			contentAttr{},
+			contentSpan(contentAttr{lang: ISOtoHTML["jpn"]}, r.reading),
+			" ",
+			contentSpan(countAttr, "×"+strconv.Itoa(r.count)),
+			" ",
+			contentSpan(nameTypeAttr, nameTypeHeading(r.nameTypes, language)),
+		))
+	}
+	attr := listAttr("", "circle", "readings")
+	term.Glossary = []any{contentStructure(contentUnorderedList(attr, listItems...))}
+	return term
+}
+
+// Builds a dictionary of the kanji strings used as surnames and given
+// names in JMnedict, listing every reading observed for each string
+// along with the number of entries using it. This makes it possible
+// to read full names which are not themselves listed in JMnedict.
+func jmnedictComponentsExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	reader, err := os.Open(inputPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	dictionary, entities, err := jmdict.LoadJmnedictNoTransform(reader)
+	if err != nil {
+		return err
+	}
+
+	languageCode := langNameToCode[language]
+	if languageCode == "" {
+		languageCode = "eng"
+	}
+
+	components := []*nameComponent{}
+	expressionToComponent := map[string]*nameComponent{}
+	for _, entry := range dictionary.Entries {
+		nameTypes := []string{}
+		for _, translation := range entry.Translations {
+			nameTypes = appendStringUnique(nameTypes, intersection(translation.NameTypes, nameComponentTypes)...)
+		}
+		if len(nameTypes) == 0 {
+			continue
+		}
+		for _, headword := range jmnedictHeadwords(entry) {
+			if headword.IsKanaOnly() {
+				continue
+			}
+			component, ok := expressionToComponent[headword.Expression]
+			if !ok {
+				component = &nameComponent{expression: headword.Expression}
+				expressionToComponent[headword.Expression] = component
+				components = append(components, component)
+			}
+			component.addReading(headword.Reading, nameTypes)
+		}
+	}
+
+	terms := dbTermList{}
+	for idx, component := range components {
+		terms = append(terms, nameComponentTerm(component, sequence(idx+1), languageCode))
+	}
+
+	if options.ScoreStats {
+		printScoreStats(outputPath, terms, options.scoringProfile())
+	}
+
+	tags := dbTagList{}
+	for _, tag := range entityTags(entities) {
+		if slices.Contains(nameComponentTypes, tag.Name) {
+			tags = append(tags, tag)
+		}
+	}
+
+	recordData := map[string]dbRecordList{
+		"term": terms.crush(),
+		"tag":  tags.crush(),
+	}
+
+	if title == "" {
+		title = "JMnedict Name Components"
+	}
+
+	index := dbIndex{
+		Title:       title,
+		Revision:    "JMnedict." + jmnedictPublicationDate(dictionary),
+		Sequenced:   true,
+		Description: "Readings of the kanji used in JMnedict surnames and given names, ordered by the number of entries using them.",
+		Attribution: edrdgAttribution,
+	}
+
+	return writeDb(
+		outputPath,
+		index,
+		recordData,
+		stride,
+		pretty,
+	)
+}
@@ -1,21 +1,44 @@
 package yomitan
 
 import (
+	"sort"
+
 	"golang.org/x/exp/slices"
 )
 
-type genericTermMap map[string]map[string][]string
+type genericReadings struct {
+	readings []string
+	counts   map[string]int
+}
+
+// Readings sorted by the number of entries in which they occur.
+// Readings with equal counts keep the order in which they were seen.
+func (r *genericReadings) sorted() []string {
+	readings := slices.Clone(r.readings)
+	sort.SliceStable(readings, func(i, j int) bool {
+		return r.counts[readings[i]] > r.counts[readings[j]]
+	})
+	return readings
+}
+
+type genericTermMap map[string]map[string]*genericReadings
 
+// Slices record the order in which expressions and name types are
+// first encountered so that the generated terms, and the sequence
+// numbers assigned to them, are the same for every build.
 type genericTermInfo struct {
-	expressionToTagToGlosses genericTermMap
-	usedSequences            map[sequence]bool
-	currentSequence          sequence
+	expressionToTagToReadings genericTermMap
+	expressions               []string
+	expressionToTags          map[string][]string
+	usedSequences             map[sequence]bool
+	currentSequence           sequence
 }
 
 func newGenericTermInfo() genericTermInfo {
 	return genericTermInfo{
-		expressionToTagToGlosses: genericTermMap{},
-		usedSequences:            map[sequence]bool{},
+		expressionToTagToReadings: genericTermMap{},
+		expressionToTags:          map[string][]string{},
+		usedSequences:             map[sequence]bool{},
 	}
 }
 
@@ -33,16 +56,22 @@ func (i *genericTermInfo) AddUsedSequence(s sequence) {
 	i.usedSequences[s] = true
 }
 
-func (i *genericTermInfo) AddGlosses(exp string, tags []string, gloss string) {
-	if i.expressionToTagToGlosses[exp] == nil {
-		i.expressionToTagToGlosses[exp] = map[string][]string{}
+func (i *genericTermInfo) AddReading(exp string, tags []string, reading string) {
+	if i.expressionToTagToReadings[exp] == nil {
+		i.expressionToTagToReadings[exp] = map[string]*genericReadings{}
+		i.expressions = append(i.expressions, exp)
 	}
 	for _, tag := range tags {
-		glosses := i.expressionToTagToGlosses[exp][tag]
-		if !slices.Contains(glosses, gloss) {
-			glosses = append(glosses, gloss)
-			i.expressionToTagToGlosses[exp][tag] = glosses
+		readings := i.expressionToTagToReadings[exp][tag]
+		if readings == nil {
+			readings = &genericReadings{counts: map[string]int{}}
+			i.expressionToTagToReadings[exp][tag] = readings
+			i.expressionToTags[exp] = append(i.expressionToTags[exp], tag)
 		}
+		if readings.counts[reading] == 0 {
+			readings.readings = append(readings.readings, reading)
+		}
+		readings.counts[reading] += 1
 	}
 }
 
@@ -61,20 +90,30 @@ func (i *genericTermInfo) IsGenericName(headword headword, definitions []string)
 	return isGenericName
 }
 
-func (i *genericTermInfo) Terms() (terms []dbTerm) {
-	for expression, tagToGlosses := range i.expressionToTagToGlosses {
-		seq := i.NewSequence()
-		for tag, glosses := range tagToGlosses {
-			term := dbTerm{
-				Expression: expression,
-				Sequence:   seq,
+func (i *genericTermInfo) Terms(meta jmnedictMetadata) (terms []dbTerm) {
+	for _, expression := range i.expressions {
+		term := dbTerm{
+			Expression: expression,
+			Sequence:   i.NewSequence(),
+		}
+		glossaryContents := []any{}
+		for _, tag := range i.expressionToTags[expression] {
+			term.addDefinitionTags(tag)
+			headingAttr := contentAttr{
+				fontWeight: "bold",
+				data:       map[string]string{"content": "nameType"},
 			}
-			for _, gloss := range glosses {
-				term.Glossary = append(term.Glossary, gloss)
+			glossaryContents = append(glossaryContents, contentDiv(headingAttr, nameTypeHeading([]string{tag}, meta.language)))
+
+			listItems := []any{}
+			for _, reading := range i.expressionToTagToReadings[expression][tag].sorted() {
+				listItems = append(listItems, contentListItem(contentAttr{}, reading))
 			}
-			term.addDefinitionTags(tag)
-			terms = append(terms, term)
+			attr := listAttr(ISOtoHTML["jpn"], "circle", "readings")
+			glossaryContents = append(glossaryContents, contentUnorderedList(attr, listItems...))
 		}
+		term.Glossary = []any{contentStructure(glossaryContents...)}
+		terms = append(terms, term)
 	}
 	return terms
 }
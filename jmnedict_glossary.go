@@ -0,0 +1,115 @@
+package yomitan
+
+import (
+	"fmt"
+	"strings"
+
+	jmdict "github.com/themoeway/jmdict-go"
+)
+
+func nameTypeHeading(nameTypes []string, language string) string {
+	names := []string{}
+	for _, nameType := range nameTypes {
+		if name, ok := nameTypeCodeToName[LangCode{language, nameType}]; ok {
+			names = append(names, name)
+		} else {
+			fmt.Println("Unknown name type code " + nameType + " for build language " + language)
+			names = append(names, nameType)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func makeNameHeader(headword headword, romaji []string, meta jmnedictMetadata) any {
+	contents := []any{}
+	if headword.Expression == headword.Reading {
+		contents = append(contents, contentSpan(contentAttr{lang: ISOtoHTML["jpn"]}, headword.Expression))
+	} else {
+		contents = append(contents, contentSpan(contentAttr{lang: ISOtoHTML["jpn"]}, headword.Expression+"（"+headword.Reading+"）"))
+	}
+	if len(romaji) > 0 {
+		attr := contentAttr{
+			lang:      ISOtoHTML[meta.language],
+			fontStyle: "italic",
+			data:      map[string]string{"content": "romaji"},
+		}
+		contents = append(contents, " ", contentSpan(attr, strings.Join(romaji, ", ")))
+	}
+	return contentDiv(contentAttr{data: map[string]string{"content": "nameHeader"}}, contents...)
+}
+
+func makeRelatedReadings(headword headword, meta jmnedictMetadata) (any, bool) {
+	if headword.Expression == headword.Reading {
+		return nil, false
+	}
+	contents := []any{}
+	for _, reading := range meta.expressionToReadings[headword.Expression] {
+		if reading == headword.Reading {
+			continue
+		}
+		related := headword
+		related.Reading = reading
+		if len(contents) > 0 {
+			contents = append(contents, "、")
+		}
+		contents = append(contents, related.ToInternalLink(true))
+	}
+	if len(contents) == 0 {
+		return nil, false
+	}
+	hint := nameNoteHint[LangCode{meta.language, "readings"}]
+	contents = append([]any{hint + ": "}, contents...)
+	attr := contentAttr{
+		fontSize: "80%",
+		data:     map[string]string{"content": "relatedReadings"},
+	}
+	return contentDiv(attr, contents...), true
+}
+
+func createNameGlossary(headword headword, senses []jmdict.JmnedictTranslation, meta jmnedictMetadata) []any {
+	// Translations which are romanizations of the reading are
+	// displayed next to the Japanese instead of as definitions.
+	romaji := []string{}
+	headings := []string{}
+	headingToTranslations := map[string][]string{}
+	for _, sense := range senses {
+		heading := nameTypeHeading(sense.NameTypes, meta.language)
+		if _, ok := headingToTranslations[heading]; !ok {
+			headings = append(headings, heading)
+			headingToTranslations[heading] = []string{}
+		}
+		for _, translation := range sense.Translations {
+			if isTransliteration(translation, headword.Reading) {
+				romaji = appendStringUnique(romaji, translation)
+			} else {
+				headingToTranslations[heading] = appendStringUnique(headingToTranslations[heading], translation)
+			}
+		}
+	}
+
+	glossaryContents := []any{makeNameHeader(headword, romaji, meta)}
+	for _, heading := range headings {
+		if heading != "" {
+			headingAttr := contentAttr{
+				fontWeight: "bold",
+				data:       map[string]string{"content": "nameType"},
+			}
+			glossaryContents = append(glossaryContents, contentDiv(headingAttr, heading))
+		}
+
+		listItems := []any{}
+		for _, translation := range headingToTranslations[heading] {
+			listItems = append(listItems, contentListItem(contentAttr{}, translation))
+		}
+		if len(listItems) > 0 {
+			attr := listAttr(ISOtoHTML[meta.language], "circle", "glossary")
+			glossaryContents = append(glossaryContents, contentUnorderedList(attr, listItems...))
+		}
+	}
+
+	if related, ok := makeRelatedReadings(headword, meta); ok {
+		glossaryContents = append(glossaryContents, related)
+	}
+
+	return []any{contentStructure(glossaryContents...)}
+}
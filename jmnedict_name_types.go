@@ -0,0 +1,137 @@
+package yomitan
+
+import (
+	"errors"
+	"strings"
+
+	jmdict "github.com/themoeway/jmdict-go"
+	"golang.org/x/exp/slices"
+)
+
+// Named groups of JMnedict name types which can be used in place of
+// the individual types when filtering or splitting a build.
+var nameTypeGroups = map[string][]string{
+	"people":        {"surname", "given", "masc", "fem", "person"},
+	"places":        {"place", "station"},
+	"organizations": {"company", "organization", "group"},
+	"works":         {"product", "work", "doc", "ev", "fict", "ship"},
+	"other":         {"char", "creat", "dei", "leg", "myth", "obj", "oth", "relig", "serv", "unclass"},
+}
+
+var nameTypeGroupToName = map[string]string{
+	"people":        "People",
+	"places":        "Places",
+	"organizations": "Organizations",
+	"works":         "Works",
+	"other":         "Other",
+}
+
+type jmnedictSubset struct {
+	name      string
+	nameTypes []string
+	generic   genericTermInfo
+	terms     dbTermList
+}
+
+func newJmnedictSubset(name string, nameTypes []string) *jmnedictSubset {
+	return &jmnedictSubset{
+		name:      name,
+		nameTypes: nameTypes,
+		generic:   newGenericTermInfo(),
+		terms:     dbTermList{},
+	}
+}
+
+func isNameType(nameType string) bool {
+	_, ok := nameTypeCodeToName[LangCode{"eng", nameType}]
+	return ok
+}
+
+// Parses a comma-separated list of name types and name type groups.
+// Each item becomes its own subset when splitting the build;
+// otherwise every item is merged into a single subset. A nil subset
+// list means that no filtering takes place.
+func parseJmnedictSubsets(nameTypeList string, split bool) ([]*jmnedictSubset, error) {
+	if nameTypeList == "" {
+		if split {
+			return nil, errors.New("splitting JMnedict requires a list of name types")
+		}
+		return nil, nil
+	}
+
+	subsets := []*jmnedictSubset{}
+	for _, item := range strings.Split(nameTypeList, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		var nameTypes []string
+		if group, ok := nameTypeGroups[item]; ok {
+			nameTypes = group
+		} else if isNameType(item) {
+			nameTypes = []string{item}
+		} else {
+			return nil, errors.New("Unrecognized name type: " + item)
+		}
+		subsets = append(subsets, newJmnedictSubset(item, nameTypes))
+	}
+
+	if !split && len(subsets) > 1 {
+		names := []string{}
+		nameTypes := []string{}
+		for _, subset := range subsets {
+			names = append(names, subset.name)
+			nameTypes = appendStringUnique(nameTypes, subset.nameTypes...)
+		}
+		subsets = []*jmnedictSubset{newJmnedictSubset(strings.Join(names, ","), nameTypes)}
+	}
+
+	return subsets, nil
+}
+
+// Returns a copy of the entry containing only the translations of
+// the subset's name types.
+func (s *jmnedictSubset) filterEntry(entry jmdict.JmnedictEntry) jmdict.JmnedictEntry {
+	translations := []jmdict.JmnedictTranslation{}
+	for _, translation := range entry.Translations {
+		nameTypes := intersection(translation.NameTypes, s.nameTypes)
+		if len(nameTypes) == 0 {
+			continue
+		}
+		translation.NameTypes = nameTypes
+		translations = append(translations, translation)
+	}
+	entry.Translations = translations
+	return entry
+}
+
+func (s *jmnedictSubset) filterTags(tags []dbTag) []dbTag {
+	filtered := []dbTag{}
+	for _, tag := range tags {
+		if isNameType(tag.Name) && !slices.Contains(s.nameTypes, tag.Name) {
+			continue
+		}
+		filtered = append(filtered, tag)
+	}
+	return filtered
+}
+
+func (s *jmnedictSubset) displayName() string {
+	names := []string{}
+	for _, item := range strings.Split(s.name, ",") {
+		if name, ok := nameTypeGroupToName[item]; ok {
+			names = append(names, name)
+		} else {
+			names = append(names, nameTypeCodeToName[LangCode{"eng", item}])
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func (s *jmnedictSubset) description() string {
+	return "JMnedict names of type: " + strings.Join(s.nameTypes, ", ")
+}
+
+func (s *jmnedictSubset) fileName() string {
+	return "jmnedict_" + strings.ReplaceAll(s.name, ",", "_") + ".zip"
+}
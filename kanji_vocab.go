@@ -0,0 +1,202 @@
+package yomitan
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	jmdict "github.com/themoeway/jmdict-go"
+	"golang.org/x/exp/slices"
+)
+
+// Maximum number of words listed for each kanji.
+const kanjiVocabLimit = 20
+
+type kanjiVocabWord struct {
+	headword headword
+	newsRank int
+	position int
+}
+
+// Returns the rank in thousands of the headword's news frequency tag,
+// or a rank past the end of the news list if it has none.
+func headwordNewsRank(h headword) int {
+	for _, tag := range h.TermTags {
+		var rank int
+		if _, err := fmt.Sscanf(tag, "news%dk", &rank); err == nil {
+			return rank
+		}
+	}
+	return 25
+}
+
+func sortKanjiVocabWords(words []kanjiVocabWord) {
+	sort.SliceStable(words, func(i, j int) bool {
+		if a, b := words[i].headword.Score(), words[j].headword.Score(); a != b {
+			return a > b
+		}
+		if words[i].newsRank != words[j].newsRank {
+			return words[i].newsRank < words[j].newsRank
+		}
+		return words[i].position < words[j].position
+	})
+}
+
+// Readings of a kanji in the form in which they appear in the reading
+// of a word: on'yomi in hiragana and kun'yomi without okurigana.
+func kanjiVocabReadings(entry jmdict.KanjidicCharacter) []string {
+	readings := []string{}
+	if entry.ReadingMeaning == nil {
+		return readings
+	}
+	for _, r := range entry.ReadingMeaning.Readings {
+		var reading string
+		switch r.Type {
+		case "ja_on":
+			reading = katakanaToHiragana(r.Value)
+		case "ja_kun":
+			reading, _, _ = strings.Cut(r.Value, ".")
+		default:
+			continue
+		}
+		reading = strings.Trim(reading, "-")
+		if reading != "" {
+			readings = appendStringUnique(readings, reading)
+		}
+	}
+	// Prefer the longest match when looking readings up in words.
+	sort.SliceStable(readings, func(i, j int) bool {
+		return len([]rune(readings[i])) > len([]rune(readings[j]))
+	})
+	return readings
+}
+
+// Guesses which reading of the kanji is used in the word. Sound
+// changes such as rendaku are not accounted for, so there may be no
+// match.
+func kanjiVocabReadingInWord(h headword, readings []string) (string, bool) {
+	for _, reading := range readings {
+		if strings.Contains(h.Reading, reading) {
+			return reading, true
+		}
+	}
+	return "", false
+}
+
+func kanjiVocabTerm(character string, words []kanjiVocabWord, readings []string) dbTerm {
+	listItems := []any{}
+	for _, word := range words {
+		contents := []any{word.headword.ToInternalLink(true)}
+		if reading, ok := kanjiVocabReadingInWord(word.headword, readings); ok {
+			attr := contentAttr{
+				lang:     ISOtoHTML["jpn"],
+				fontSize: "80%",
+				data:     map[string]string{"content": "kanjiReading"},
+			}
+			contents = append(contents, " ", contentSpan(attr, "［"+reading+"］"))
+		}
+		listItems = append(listItems, contentListItem(contentAttr{}, contents...))
+	}
+
+	term := dbTerm{
+		Expression: character,
+		Glossary: []any{contentStructure(
+			contentUnorderedList(listAttr("", "circle", "kanjiVocab"), listItems...),
+		)},
+	}
+	term.addDefinitionTags("vocab")
+	return term
+}
+
+// Builds a term dictionary listing, for each KANJIDIC2 kanji, the most
+// common JMdict words written with it. The input path is the JMdict
+// file and the KANJIDIC2 file is given by the KanjidicPath option.
+func kanjiVocabExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	if options.KanjidicPath == "" {
+		return errors.New("a KANJIDIC2 file is required to build the kanji vocabulary dictionary")
+	}
+
+	kanjidic, err := kanjidicLoad(options.KanjidicPath)
+	if err != nil {
+		return err
+	}
+
+	dictionary, _, err := jmdictLoad(inputPath)
+	if err != nil {
+		return err
+	}
+
+	characterToWords := map[string][]kanjiVocabWord{}
+	for _, character := range kanjidic.Characters {
+		characterToWords[character.Literal] = []kanjiVocabWord{}
+	}
+
+	position := 0
+	for _, entry := range dictionary.Entries {
+		for _, h := range extractHeadwords(entry) {
+			if h.IsKanaOnly() || h.IsSearchOnly || h.Reading == "" {
+				continue
+			}
+			word := kanjiVocabWord{
+				headword: h,
+				newsRank: headwordNewsRank(h),
+				position: position,
+			}
+			position += 1
+			characters := []string{}
+			for _, r := range h.Expression {
+				character := string(r)
+				if slices.Contains(characters, character) {
+					continue
+				}
+				characters = append(characters, character)
+				if words, ok := characterToWords[character]; ok {
+					characterToWords[character] = append(words, word)
+				}
+			}
+		}
+	}
+
+	terms := dbTermList{}
+	for _, character := range kanjidic.Characters {
+		words := characterToWords[character.Literal]
+		if len(words) == 0 {
+			continue
+		}
+		sortKanjiVocabWords(words)
+		if len(words) > kanjiVocabLimit {
+			words = words[:kanjiVocabLimit]
+		}
+		terms = append(terms, kanjiVocabTerm(character.Literal, words, kanjiVocabReadings(character)))
+	}
+
+	tags := dbTagList{
+		dbTag{Name: "vocab", Notes: "common words written with this kanji", Category: "kanjiVocab"},
+	}
+
+	recordData := map[string]dbRecordList{
+		"term": terms.crush(),
+		"tag":  tags.crush(),
+	}
+
+	if title == "" {
+		title = "Kanji Vocabulary"
+	}
+
+	index := dbIndex{
+		Title:       title,
+		Revision:    "JMdict." + jmdictPublicationDate(dictionary),
+		Sequenced:   false,
+		Description: "Common JMdict words for each KANJIDIC2 kanji",
+		Attribution: edrdgAttribution,
+	}
+
+	return writeDb(
+		outputPath,
+		index,
+		recordData,
+		stride,
+		pretty,
+	)
+}
@@ -1,13 +1,206 @@
 package yomitan
 
 import (
+	"encoding/xml"
+	"errors"
+	"io"
 	"os"
 	"strconv"
+	"strings"
 
 	jmdict "github.com/themoeway/jmdict-go"
+	"golang.org/x/exp/slices"
 )
 
-func kanjidicExtractKanji(entry jmdict.KanjidicCharacter, language string) *dbKanji {
+// Language used by the multi-language mode, which exports the meanings
+// of every language in the file prefixed by their language code.
+const kanjidicAllLanguages = "all"
+
+// Names of the meaning languages of KANJIDIC2 which are not among the
+// JMdict language names. English meanings have no m_lang attribute.
+var kanjidicLanguageNames = map[string]string{
+	"":           "",
+	"english":    "",
+	"french":     "fr",
+	"spanish":    "es",
+	"portuguese": "pt",
+}
+
+func kanjidicMeaningLanguage(m jmdict.KanjidicMeaning) string {
+	if m.Language == nil {
+		return ""
+	}
+	return *m.Language
+}
+
+// Returns the m_lang values used in the file in order of appearance.
+func kanjidicLanguages(dict jmdict.Kanjidic) []string {
+	languages := []string{}
+	for _, entry := range dict.Characters {
+		if entry.ReadingMeaning == nil {
+			continue
+		}
+		for _, m := range entry.ReadingMeaning.Meanings {
+			languages = appendStringUnique(languages, kanjidicMeaningLanguage(m))
+		}
+	}
+	return languages
+}
+
+// Resolves the language parameter, which may be a language name or an
+// m_lang code, to the list of m_lang values to export.
+func kanjidicSelectLanguages(language string, available []string) ([]string, error) {
+	if language == kanjidicAllLanguages {
+		return available, nil
+	}
+
+	langTag, ok := kanjidicLanguageNames[language]
+	if !ok {
+		if code, ok := langNameToCode[language]; ok {
+			langTag = ISOtoHTML[code]
+		} else {
+			langTag = language
+		}
+	}
+	if langTag == "en" {
+		langTag = ""
+	}
+
+	if !slices.Contains(available, langTag) {
+		codes := []string{}
+		for _, code := range available {
+			if code == "" {
+				code = "en"
+			}
+			codes = append(codes, code)
+		}
+		return nil, errors.New("Unrecognized language parameter: " + language + " (available: " + strings.Join(codes, ", ") + ")")
+	}
+
+	return []string{langTag}, nil
+}
+
+// Optional groups of KANJIDIC2 data, selected by a comma-separated list.
+const (
+	kanjidicNanori   = "nanori"
+	kanjidicRadicals = "radicals"
+	kanjidicReadings = "readings"
+	kanjidicVariants = "variants"
+	kanjidicStrokes  = "strokes"
+)
+
+var kanjidicDataGroups = []string{
+	kanjidicNanori,
+	kanjidicRadicals,
+	kanjidicReadings,
+	kanjidicVariants,
+	kanjidicStrokes,
+}
+
+func kanjidicSelectDataGroups(groupList string) ([]string, error) {
+	groups := []string{}
+	for _, group := range strings.Split(groupList, ",") {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			continue
+		}
+		if !slices.Contains(kanjidicDataGroups, group) {
+			return nil, errors.New("Unrecognized KANJIDIC data group: " + group)
+		}
+		groups = appendStringUnique(groups, group)
+	}
+	return groups, nil
+}
+
+// Labels of the non-Japanese readings, which are listed after the
+// meanings of a kanji.
+var kanjidicReadingTypes = []struct {
+	readingType string
+	label       string
+}{
+	{"pinyin", "Pinyin"},
+	{"korean_r", "Korean"},
+	{"korean_h", "Hangul"},
+	{"vietnam", "Vietnamese"},
+}
+
+func kanjidicExtractReadings(kanji *dbKanji, readings []jmdict.KanjidicReading) {
+	for _, readingType := range kanjidicReadingTypes {
+		values := []string{}
+		for _, r := range readings {
+			if r.Type == readingType.readingType {
+				values = append(values, r.Value)
+			}
+		}
+		if len(values) > 0 {
+			kanji.Meanings = append(kanji.Meanings, readingType.label+": "+strings.Join(values, ", "))
+		}
+	}
+}
+
+func kanjidicExtractRadicals(kanji *dbKanji, entry jmdict.KanjidicCharacter) {
+	for _, radical := range entry.Radical {
+		kanji.Stats["rad_"+radical.Type] = radical.Value
+	}
+	if names := entry.Misc.RadicalName; len(names) > 0 {
+		kanji.Stats["rad_name"] = strings.Join(names, "、")
+	}
+}
+
+// The var_type of a variant is an attribute, but jmdict-go decodes it
+// as a child element, which leaves KanjidicVariant.Type empty. The
+// variants are therefore decoded from the file a second time.
+type kanjidicVariant struct {
+	Value string `xml:",chardata"`
+	Type  string `xml:"var_type,attr"`
+}
+
+type kanjidicVariantCharacter struct {
+	Literal  string            `xml:"literal"`
+	Variants []kanjidicVariant `xml:"misc>variant"`
+}
+
+// Returns the variants of every character keyed by its literal.
+func kanjidicLoadVariants(reader io.Reader) (map[string][]kanjidicVariant, error) {
+	variants := map[string][]kanjidicVariant{}
+	decoder := xml.NewDecoder(reader)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return variants, nil
+		} else if err != nil {
+			return nil, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "character" {
+			continue
+		}
+		var character kanjidicVariantCharacter
+		if err := decoder.DecodeElement(&character, &start); err != nil {
+			return nil, err
+		}
+		if len(character.Variants) > 0 {
+			variants[character.Literal] = character.Variants
+		}
+	}
+}
+
+func kanjidicExtractVariants(kanji *dbKanji, variants []kanjidicVariant) {
+	typeToValues := map[string][]string{}
+	types := []string{}
+	for _, variant := range variants {
+		key := "var_" + variant.Type
+		if _, ok := typeToValues[key]; !ok {
+			types = append(types, key)
+		}
+		typeToValues[key] = append(typeToValues[key], variant.Value)
+	}
+	for _, key := range types {
+		kanji.Stats[key] = strings.Join(typeToValues[key], ", ")
+	}
+}
+
+func kanjidicExtractKanji(entry jmdict.KanjidicCharacter, variants []kanjidicVariant, languages []string, prefixLanguage bool, groups []string) *dbKanji {
 	if entry.ReadingMeaning == nil {
 		return nil
 	}
@@ -18,7 +211,16 @@ func kanjidicExtractKanji(entry jmdict.KanjidicCharacter, language string) *dbKa
 	}
 
 	for _, m := range entry.ReadingMeaning.Meanings {
-		if m.Language == nil && language == "" || m.Language != nil && language == *m.Language {
+		langTag := kanjidicMeaningLanguage(m)
+		if !slices.Contains(languages, langTag) {
+			continue
+		}
+		if prefixLanguage {
+			if langTag == "" {
+				langTag = "en"
+			}
+			kanji.Meanings = append(kanji.Meanings, "["+langTag+"] "+m.Meaning)
+		} else {
 			kanji.Meanings = append(kanji.Meanings, m.Meaning)
 		}
 	}
@@ -41,6 +243,17 @@ func kanjidicExtractKanji(entry jmdict.KanjidicCharacter, language string) *dbKa
 
 	if counts := entry.Misc.StrokeCounts; len(counts) > 0 {
 		kanji.Stats["strokes"] = counts[0]
+		if len(counts) > 1 && slices.Contains(groups, kanjidicStrokes) {
+			kanji.Stats["strokes_alt"] = strings.Join(counts[1:], ", ")
+		}
+	}
+
+	if slices.Contains(groups, kanjidicRadicals) {
+		kanjidicExtractRadicals(&kanji, entry)
+	}
+
+	if slices.Contains(groups, kanjidicVariants) {
+		kanjidicExtractVariants(&kanji, variants)
 	}
 
 	for _, code := range entry.Codepoint {
@@ -71,34 +284,66 @@ func kanjidicExtractKanji(entry jmdict.KanjidicCharacter, language string) *dbKa
 		}
 	}
 
+	// Name readings are only used in names, so they are listed after
+	// the meanings rather than among the kun readings.
+	if nanori := entry.ReadingMeaning.Nanori; len(nanori) > 0 && slices.Contains(groups, kanjidicNanori) {
+		kanji.Meanings = append(kanji.Meanings, "Nanori: "+strings.Join(nanori, ", "))
+	}
+
+	if slices.Contains(groups, kanjidicReadings) {
+		kanjidicExtractReadings(&kanji, entry.ReadingMeaning.Readings)
+	}
+
 	return &kanji
 }
 
-func kanjidicExportDb(inputPath, outputPath, language, title string, stride int, pretty bool) error {
+func kanjidicLoad(inputPath string) (jmdict.Kanjidic, error) {
 	reader, err := os.Open(inputPath)
 	if err != nil {
-		return err
+		return jmdict.Kanjidic{}, err
+	}
+	defer reader.Close()
+
+	return jmdict.LoadKanjidic(reader)
+}
+
+func kanjidicLoadVariantsFile(inputPath string) (map[string][]kanjidicVariant, error) {
+	reader, err := os.Open(inputPath)
+	if err != nil {
+		return nil, err
 	}
 	defer reader.Close()
 
-	dict, err := jmdict.LoadKanjidic(reader)
+	return kanjidicLoadVariants(reader)
+}
+
+func kanjidicExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	dict, err := kanjidicLoad(inputPath)
 	if err != nil {
 		return err
 	}
 
-	var langTag string
-	switch language {
-	case "french":
-		langTag = "fr"
-	case "spanish":
-		langTag = "es"
-	case "portuguese":
-		langTag = "pt"
+	languages, err := kanjidicSelectLanguages(language, kanjidicLanguages(dict))
+	if err != nil {
+		return err
+	}
+	prefixLanguage := language == kanjidicAllLanguages
+
+	groups, err := kanjidicSelectDataGroups(options.KanjiData)
+	if err != nil {
+		return err
+	}
+
+	variants := map[string][]kanjidicVariant{}
+	if slices.Contains(groups, kanjidicVariants) {
+		if variants, err = kanjidicLoadVariantsFile(inputPath); err != nil {
+			return err
+		}
 	}
 
 	var kanji dbKanjiList
 	for _, entry := range dict.Characters {
-		kanjiCurr := kanjidicExtractKanji(entry, langTag)
+		kanjiCurr := kanjidicExtractKanji(entry, variants[entry.Literal], languages, prefixLanguage, groups)
 		if kanjiCurr != nil {
 			kanji = append(kanji, *kanjiCurr)
 		}
@@ -116,6 +361,21 @@ func kanjidicExportDb(inputPath, outputPath, language, title string, stride int,
 		dbTag{Name: "grade", Notes: "Grade level", Category: "misc"},
 		dbTag{Name: "jlpt", Notes: "JLPT level", Category: "misc"},
 		dbTag{Name: "strokes", Notes: "Stroke count", Category: "misc"},
+		dbTag{Name: "strokes_alt", Notes: "Common miscounts of the stroke count", Category: "misc"},
+
+		dbTag{Name: "rad_classical", Notes: "Classical (Kangxi) radical number", Category: "radical"},
+		dbTag{Name: "rad_nelson_c", Notes: "Radical number in Nelson's Modern Reader's Japanese-English Character Dictionary", Category: "radical"},
+		dbTag{Name: "rad_name", Notes: "Radical name", Category: "radical"},
+
+		dbTag{Name: "var_jis208", Notes: "Variant JIS X 0208 kuten code", Category: "variant"},
+		dbTag{Name: "var_jis212", Notes: "Variant JIS X 0212 kuten code", Category: "variant"},
+		dbTag{Name: "var_jis213", Notes: "Variant JIS X 0213 kuten code", Category: "variant"},
+		dbTag{Name: "var_deroo", Notes: "Variant De Roo number", Category: "variant"},
+		dbTag{Name: "var_njecd", Notes: "Variant Halpern NJECD index number", Category: "variant"},
+		dbTag{Name: "var_s_h", Notes: "Variant Kanji Dictionary descriptor", Category: "variant"},
+		dbTag{Name: "var_nelson_c", Notes: "Variant Classic Nelson index number", Category: "variant"},
+		dbTag{Name: "var_oneill", Notes: "Variant Japanese Names index number", Category: "variant"},
+		dbTag{Name: "var_ucs", Notes: "Variant Unicode hex code", Category: "variant"},
 
 		dbTag{Name: "jis208", Notes: "JIS X 0208-1997 kuten code", Category: "code"},
 		dbTag{Name: "jis212", Notes: "JIS X 0212-1990 kuten code", Category: "code"},
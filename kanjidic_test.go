@@ -0,0 +1,50 @@
+package yomitan
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKanjidicVariants(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE kanjidic2 [
+<!ELEMENT kanjidic2 (header,character*)>
+]>
+<kanjidic2>
+<character>
+<literal>亜</literal>
+<misc>
+<grade>8</grade>
+<variant var_type="jis208">1-48-19</variant>
+<variant var_type="jis212">1-16-1</variant>
+<variant var_type="jis208">1-48-20</variant>
+</misc>
+</character>
+<character>
+<literal>唖</literal>
+<misc><stroke_count>10</stroke_count></misc>
+</character>
+</kanjidic2>`
+
+	variants, err := kanjidicLoadVariants(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]kanjidicVariant{
+		"亜": {{"1-48-19", "jis208"}, {"1-16-1", "jis212"}, {"1-48-20", "jis208"}},
+	}
+	if !reflect.DeepEqual(variants, want) {
+		t.Fatalf("variants = %v, want %v", variants, want)
+	}
+
+	kanji := dbKanji{Stats: map[string]string{}}
+	kanjidicExtractVariants(&kanji, variants["亜"])
+	wantStats := map[string]string{
+		"var_jis208": "1-48-19, 1-48-20",
+		"var_jis212": "1-16-1",
+	}
+	if !reflect.DeepEqual(kanji.Stats, wantStats) {
+		t.Errorf("stats = %v, want %v", kanji.Stats, wantStats)
+	}
+}
@@ -0,0 +1,170 @@
+package yomitan
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const kanjivgAttribution = "KanjiVG is copyright Ulrich Apel and released under the Creative Commons Attribution-Share Alike 3.0 license. See https://kanjivg.tagaini.net/"
+
+type kanjivgDiagram struct {
+	character string
+	fileName  string
+	data      []byte
+}
+
+// KanjiVG files are named after the hexadecimal code point of their
+// character, e.g. "05b57.svg". Files of variant forms carry a suffix
+// ("05b57-Kaisho.svg") and are skipped.
+func kanjivgCharacter(fileName string) (string, bool) {
+	if !strings.EqualFold(path.Ext(fileName), ".svg") {
+		return "", false
+	}
+	name := strings.TrimSuffix(path.Base(fileName), path.Ext(fileName))
+	if strings.Contains(name, "-") {
+		return "", false
+	}
+	codePoint, err := strconv.ParseInt(name, 16, 32)
+	if err != nil {
+		return "", false
+	}
+	return string(rune(codePoint)), true
+}
+
+func kanjivgLoadDirectory(inputPath string) ([]kanjivgDiagram, error) {
+	diagrams := []kanjivgDiagram{}
+	err := filepath.WalkDir(inputPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		character, ok := kanjivgCharacter(entry.Name())
+		if !ok {
+			return nil
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		diagrams = append(diagrams, kanjivgDiagram{character, entry.Name(), data})
+		return nil
+	})
+	return diagrams, err
+}
+
+func kanjivgLoadArchive(inputPath string) ([]kanjivgDiagram, error) {
+	archive, err := zip.OpenReader(inputPath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	diagrams := []kanjivgDiagram{}
+	for _, file := range archive.File {
+		character, ok := kanjivgCharacter(file.Name)
+		if !ok {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		diagrams = append(diagrams, kanjivgDiagram{character, path.Base(file.Name), data})
+	}
+	return diagrams, nil
+}
+
+func kanjivgLoad(inputPath string) ([]kanjivgDiagram, error) {
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var diagrams []kanjivgDiagram
+	if info.IsDir() {
+		diagrams, err = kanjivgLoadDirectory(inputPath)
+	} else {
+		diagrams, err = kanjivgLoadArchive(inputPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(diagrams) == 0 {
+		return nil, errors.New("no KanjiVG diagrams found in " + inputPath)
+	}
+
+	sort.Slice(diagrams, func(i, j int) bool {
+		return diagrams[i].fileName < diagrams[j].fileName
+	})
+	return diagrams, nil
+}
+
+// Builds a term dictionary whose entries show the KanjiVG stroke
+// order diagram of a kanji. The input is either the directory of SVG
+// files distributed by KanjiVG or a ZIP archive containing them; the
+// diagrams are copied into the dictionary archive unchanged.
+func kanjivgExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	diagrams, err := kanjivgLoad(inputPath)
+	if err != nil {
+		return err
+	}
+
+	terms := dbTermList{}
+	media := []dbMedia{}
+	for _, diagram := range diagrams {
+		mediaPath := "kanjivg/" + diagram.fileName
+		media = append(media, dbMedia{Path: mediaPath, Data: diagram.data})
+
+		imageAttr := contentAttr{data: map[string]string{"content": "strokeOrder"}}
+		image := contentImage(imageAttr, mediaPath, 6, 6, diagram.character)
+		term := dbTerm{
+			Expression: diagram.character,
+			Glossary:   []any{contentStructure(image)},
+		}
+		term.addDefinitionTags("stroke-order")
+		terms = append(terms, term)
+	}
+
+	tags := dbTagList{
+		dbTag{Name: "stroke-order", Notes: "stroke order diagram from KanjiVG", Category: "kanjivg"},
+	}
+
+	recordData := map[string]dbRecordList{
+		"term": terms.crush(),
+		"tag":  tags.crush(),
+	}
+
+	if title == "" {
+		title = "KanjiVG"
+	}
+
+	index := dbIndex{
+		Title:       title,
+		Revision:    "kanjivg",
+		Sequenced:   false,
+		Description: "Stroke order diagrams of kanji",
+		Attribution: kanjivgAttribution,
+	}
+
+	return writeDbMedia(
+		outputPath,
+		index,
+		recordData,
+		media,
+		stride,
+		pretty,
+	)
+}
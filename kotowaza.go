@@ -12,20 +12,27 @@ type kotowazaExtractor struct {
 	readGroupAltsExp   *regexp.Regexp
 	readGroupNoAltsExp *regexp.Regexp
 	wordGroupExp       *regexp.Regexp
+	structured         bool
 }
 
-func makeKotowazaExtractor() epwingExtractor {
+func makeKotowazaExtractor(structured bool) epwingExtractor {
 	return &kotowazaExtractor{
 		readGroupExp:       regexp.MustCompile(`([^ぁ-ゖァ-ヺ]*)(\([^)]*\))`),
 		readGroupAltsExp:   regexp.MustCompile(`\(([^)]*)\)`),
 		readGroupNoAltsExp: regexp.MustCompile(`\(([^・)]*)\)`),
 		wordGroupExp:       regexp.MustCompile(`＝([^〔＝]*)〔＝([^〕]*)〕`),
+		structured:         structured,
 	}
 }
 
 func (e *kotowazaExtractor) extractTerms(entry zig.BookEntry, sequence int) []dbTerm {
 	heading := entry.Heading
 
+	var glossary any = entry.Text
+	if e.structured {
+		glossary = epwingDefinitionContent(entry.Text, nil)
+	}
+
 	queue := []string{heading}
 	reducedExpressions := []string{}
 
@@ -72,7 +79,7 @@ func (e *kotowazaExtractor) extractTerms(entry zig.BookEntry, sequence int) []db
 			term := dbTerm{
 				Expression: expression,
 				Reading:    reading,
-				Glossary:   []any{entry.Text},
+				Glossary:   []any{glossary},
 				Sequence:   sequence,
 			}
 
@@ -88,17 +95,21 @@ func (e *kotowazaExtractor) extractKanji(entry zig.BookEntry) []dbKanji {
 	return nil
 }
 
+func (*kotowazaExtractor) extractPitch(entry zig.BookEntry, terms []dbTerm) []dbMeta {
+	return nil
+}
+
 func (e *kotowazaExtractor) exportRules(term *dbTerm, tags []string) {
 }
 
-func (*kotowazaExtractor) getRevision() string {
-	return "kotowaza1"
+func (*kotowazaExtractor) getTags() []dbTag {
+	return nil
 }
 
-func (*kotowazaExtractor) getFontNarrow() map[int]string {
-	return map[int]string{}
+func (*kotowazaExtractor) getReferenceExps() []*regexp.Regexp {
+	return epwingDefaultReferenceExps
 }
 
-func (*kotowazaExtractor) getFontWide() map[int]string {
-	return map[int]string{}
+func (*kotowazaExtractor) getRevision() string {
+	return "kotowaza1"
 }
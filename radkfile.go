@@ -0,0 +1,292 @@
+package yomitan
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// File names of the EDRDG radical decomposition files. The "2" files
+// cover the JIS X 0212 kanji which are missing from the originals.
+var (
+	kradfileNames = []string{"kradfile", "kradfile2"}
+	radkfileNames = []string{"radkfile", "radkfile2"}
+)
+
+// RADKFILE and KRADFILE stand in for radicals which have no JIS X 0208
+// code point with a kanji containing them. These are replaced by the
+// radical forms themselves.
+var radkfileSubstitutes = map[string]string{
+	"化": "亻",
+	"个": "𠆢",
+	"并": "丷",
+	"刈": "刂",
+	"込": "⻌",
+	"尚": "⺌",
+	"忙": "忄",
+	"扎": "扌",
+	"汁": "氵",
+	"犯": "犭",
+	"艾": "⺾",
+	"邦": "⻏",
+	"阡": "⻖",
+	"老": "耂",
+	"杰": "灬",
+	"礼": "礻",
+	"疔": "疒",
+	"禹": "禸",
+	"初": "衤",
+	"買": "⺲",
+	"滴": "啇",
+	"乞": "𠂉",
+}
+
+func radkfileRadical(radical string) string {
+	if substitute, ok := radkfileSubstitutes[radical]; ok {
+		return substitute
+	}
+	return radical
+}
+
+type radkfileRadicalInfo struct {
+	radical string
+	strokes int
+	kanji   []string
+}
+
+type radkfileData struct {
+	radicals        []*radkfileRadicalInfo
+	radicalToInfo   map[string]*radkfileRadicalInfo
+	kanji           []string
+	kanjiToRadicals map[string][]string
+}
+
+// The files are distributed in EUC-JP, but converted UTF-8 copies are
+// also in circulation.
+func radkfileReadLines(filePath string) ([]string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(data) {
+		if data, err = japanese.EUCJP.NewDecoder().Bytes(data); err != nil {
+			return nil, err
+		}
+	}
+
+	lines := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// Radical lines have the form "$ 口 3" (optionally followed by a JIS
+// code or image name) and are followed by the kanji containing the
+// radical, possibly spread over several lines.
+func (d *radkfileData) loadRadkfile(filePath string) error {
+	lines, err := radkfileReadLines(filePath)
+	if err != nil {
+		return err
+	}
+
+	var current *radkfileRadicalInfo
+	for _, line := range lines {
+		if strings.HasPrefix(line, "$") {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				return errors.New("invalid radical line in " + filePath + ": " + line)
+			}
+			strokes, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return errors.New("invalid stroke count in " + filePath + ": " + line)
+			}
+			radical := radkfileRadical(fields[1])
+			current = d.radicalToInfo[radical]
+			if current == nil {
+				current = &radkfileRadicalInfo{radical: radical, strokes: strokes}
+				d.radicalToInfo[radical] = current
+				d.radicals = append(d.radicals, current)
+			}
+		} else if current != nil {
+			for _, r := range line {
+				current.kanji = appendStringUnique(current.kanji, string(r))
+			}
+		}
+	}
+	return nil
+}
+
+// Kanji lines have the form "亜 : ｜ 一 口".
+func (d *radkfileData) loadKradfile(filePath string) error {
+	lines, err := radkfileReadLines(filePath)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		kanji, radicals, ok := strings.Cut(line, ":")
+		if !ok {
+			return errors.New("invalid kanji line in " + filePath + ": " + line)
+		}
+		kanji = strings.TrimSpace(kanji)
+		if _, ok := d.kanjiToRadicals[kanji]; !ok {
+			d.kanji = append(d.kanji, kanji)
+		}
+		for _, radical := range strings.Fields(radicals) {
+			d.kanjiToRadicals[kanji] = appendStringUnique(d.kanjiToRadicals[kanji], radkfileRadical(radical))
+		}
+	}
+	return nil
+}
+
+// Loads every RADKFILE and KRADFILE found in the input directory. A
+// path to one of the files is treated as its containing directory.
+func radkfileLoad(inputPath string) (radkfileData, error) {
+	data := radkfileData{
+		radicalToInfo:   map[string]*radkfileRadicalInfo{},
+		kanjiToRadicals: map[string][]string{},
+	}
+
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return data, err
+	}
+	if !info.IsDir() {
+		inputPath = filepath.Dir(inputPath)
+	}
+
+	for _, name := range radkfileNames {
+		filePath := filepath.Join(inputPath, name)
+		if _, err := os.Stat(filePath); err != nil {
+			continue
+		}
+		if err := data.loadRadkfile(filePath); err != nil {
+			return data, err
+		}
+	}
+
+	for _, name := range kradfileNames {
+		filePath := filepath.Join(inputPath, name)
+		if _, err := os.Stat(filePath); err != nil {
+			continue
+		}
+		if err := data.loadKradfile(filePath); err != nil {
+			return data, err
+		}
+	}
+
+	if len(data.radicals) == 0 && len(data.kanji) == 0 {
+		return data, errors.New("no RADKFILE or KRADFILE found in " + inputPath)
+	}
+
+	return data, nil
+}
+
+func radkfileExtractKanji(data radkfileData) dbKanjiList {
+	kanji := dbKanjiList{}
+	for _, character := range data.kanji {
+		radicals := data.kanjiToRadicals[character]
+		entry := dbKanji{
+			Character: character,
+			Stats:     map[string]string{"components": strconv.Itoa(len(radicals))},
+		}
+		for _, radical := range radicals {
+			if info, ok := data.radicalToInfo[radical]; ok {
+				entry.Meanings = append(entry.Meanings, radical+" ("+strconv.Itoa(info.strokes)+")")
+			} else {
+				entry.Meanings = append(entry.Meanings, radical)
+			}
+		}
+		kanji = append(kanji, entry)
+	}
+	return kanji
+}
+
+func radkfileStrokeLabel(strokes int) string {
+	if strokes == 1 {
+		return "1 stroke"
+	}
+	return strconv.Itoa(strokes) + " strokes"
+}
+
+func radkfileExtractTerms(data radkfileData) dbTermList {
+	terms := dbTermList{}
+	for _, info := range data.radicals {
+		headingAttr := contentAttr{
+			fontWeight: "bold",
+			data:       map[string]string{"content": "radicalStrokes"},
+		}
+		kanjiAttr := contentAttr{
+			lang: ISOtoHTML["jpn"],
+			data: map[string]string{"content": "radicalKanji"},
+		}
+		term := dbTerm{
+			Expression: info.radical,
+			Score:      -info.strokes,
+			Glossary: []any{contentStructure(
+				contentDiv(headingAttr, radkfileStrokeLabel(info.strokes)+", "+strconv.Itoa(len(info.kanji))+" kanji"),
+				contentDiv(kanjiAttr, strings.Join(info.kanji, "")),
+			)},
+		}
+		term.addDefinitionTags("radical")
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// Builds a dictionary from the EDRDG radical decomposition files.
+// KRADFILE supplies the components of each kanji, which are exported
+// as kanji entries; RADKFILE supplies the stroke count of each radical
+// and the kanji containing it, which are exported as terms.
+func radkfileExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
+	data, err := radkfileLoad(inputPath)
+	if err != nil {
+		return err
+	}
+
+	kanji := radkfileExtractKanji(data)
+	terms := radkfileExtractTerms(data)
+
+	tags := dbTagList{
+		dbTag{Name: "radical", Notes: "kanji containing this radical", Category: "radical"},
+		dbTag{Name: "components", Notes: "Number of components", Category: "misc"},
+	}
+
+	recordData := map[string]dbRecordList{
+		"kanji": kanji.crush(),
+		"term":  terms.crush(),
+		"tag":   tags.crush(),
+	}
+
+	if title == "" {
+		title = "KRADFILE"
+	}
+
+	index := dbIndex{
+		Title:       title,
+		Revision:    "kradfile",
+		Sequenced:   false,
+		Attribution: edrdgAttribution,
+	}
+
+	return writeDb(
+		outputPath,
+		index,
+		recordData,
+		stride,
+		pretty,
+	)
+}
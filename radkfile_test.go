@@ -0,0 +1,127 @@
+package yomitan
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+func writeRadkfileTestFiles(t *testing.T, files map[string]string, eucjp bool) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		data := []byte(content)
+		if eucjp {
+			var err error
+			if data, err = japanese.EUCJP.NewEncoder().Bytes(data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestRadkfileLoad(t *testing.T) {
+	radkfile := "# comment\n$ 一 1\n亜唖\n娃\n$ 化 2 js01\n仁\n$ 口 3\n亜唖\n"
+	kradfile := "# comment\n亜 : ｜ 一 口\n仁 : 化 二\n亜 : 一\n"
+
+	tests := []struct {
+		name         string
+		files        map[string]string
+		eucjp        bool
+		wantRadicals []radkfileRadicalInfo
+		wantKanji    map[string][]string
+		wantErr      bool
+	}{
+		{
+			name:  "utf-8",
+			files: map[string]string{"radkfile": radkfile, "kradfile": kradfile},
+			wantRadicals: []radkfileRadicalInfo{
+				{radical: "一", strokes: 1, kanji: []string{"亜", "唖", "娃"}},
+				{radical: "亻", strokes: 2, kanji: []string{"仁"}},
+				{radical: "口", strokes: 3, kanji: []string{"亜", "唖"}},
+			},
+			wantKanji: map[string][]string{
+				"亜": {"｜", "一", "口"},
+				"仁": {"亻", "二"},
+			},
+		},
+		{
+			name:  "euc-jp",
+			files: map[string]string{"radkfile": radkfile},
+			eucjp: true,
+			wantRadicals: []radkfileRadicalInfo{
+				{radical: "一", strokes: 1, kanji: []string{"亜", "唖", "娃"}},
+				{radical: "亻", strokes: 2, kanji: []string{"仁"}},
+				{radical: "口", strokes: 3, kanji: []string{"亜", "唖"}},
+			},
+			wantKanji: map[string][]string{},
+		},
+		{
+			name:  "supplement",
+			files: map[string]string{"kradfile": "亜 : 一\n", "kradfile2": "丂 : 一 勹\n"},
+			wantKanji: map[string][]string{
+				"亜": {"一"},
+				"丂": {"一", "勹"},
+			},
+		},
+		{
+			name:    "invalid stroke count",
+			files:   map[string]string{"radkfile": "$ 一 one\n亜\n"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid kanji line",
+			files:   map[string]string{"kradfile": "亜 一 口\n"},
+			wantErr: true,
+		},
+		{
+			name:    "no files",
+			files:   map[string]string{"readme": "\n"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := writeRadkfileTestFiles(t, test.files, test.eucjp)
+			data, err := radkfileLoad(dir)
+			if test.wantErr {
+				if err == nil {
+					t.Error("radkfileLoad() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var radicals []radkfileRadicalInfo
+			for _, info := range data.radicals {
+				radicals = append(radicals, *info)
+			}
+			if !reflect.DeepEqual(radicals, test.wantRadicals) {
+				t.Errorf("radicals = %v, want %v", radicals, test.wantRadicals)
+			}
+			if !reflect.DeepEqual(data.kanjiToRadicals, test.wantKanji) {
+				t.Errorf("kanji = %v, want %v", data.kanjiToRadicals, test.wantKanji)
+			}
+		})
+	}
+}
+
+func TestRadkfileLoadFilePath(t *testing.T) {
+	dir := writeRadkfileTestFiles(t, map[string]string{"kradfile": "亜 : 一\n"}, false)
+	data, err := radkfileLoad(filepath.Join(dir, "kradfile"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"亜"}; !reflect.DeepEqual(data.kanji, want) {
+		t.Errorf("kanji = %v, want %v", data.kanji, want)
+	}
+}
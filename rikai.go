@@ -2,6 +2,7 @@ package yomitan
 
 import (
 	"database/sql"
+	"errors"
 	"regexp"
 	"strings"
 
@@ -14,7 +15,12 @@ type rikaiEntry struct {
 	entry string
 }
 
-func rikaiBuildRules(term *dbTerm) {
+func rikaiBuildRules(term *dbTerm, ruleSet string) {
+	if ruleSet == extendedRuleSet {
+		term.addRules(grammarRules(term.DefinitionTags, ruleSet)...)
+		return
+	}
+
 	for _, tag := range term.DefinitionTags {
 		switch tag {
 		case "adj-i", "v1", "vk", "vz":
@@ -29,28 +35,33 @@ func rikaiBuildRules(term *dbTerm) {
 	}
 }
 
-func rikaiBuildScore(term *dbTerm) {
+var rikaiTagWeights = map[string]int{
+	"news": 1,
+	"ichi": 1,
+	"spec": 1,
+	"gai":  1,
+	"arch": -1,
+	"iK":   -1,
+	"P":    5,
+}
+
+func rikaiBuildScore(term *dbTerm, profile scoringProfile) {
 	for _, tag := range term.DefinitionTags {
-		switch tag {
-		case "news", "ichi", "spec", "gai":
-			term.Score++
-		case "arch", "iK":
-			term.Score--
-		case "P":
-			term.Score += 5
+		if weight, ok := profile.Tags[tag]; ok {
+			term.Score += weight
+		} else {
+			term.Score += rikaiTagWeights[tag]
 		}
 	}
 }
 
-func rikaiExtractTerms(rows *sql.Rows) (dbTermList, error) {
+func rikaiExtractTerms(rows *sql.Rows, sequence int) (dbTermList, error) {
 	var terms dbTermList
 
 	dfnExp := regexp.MustCompile(`^(?:＊\(KC\) )?((?:\((?:[\w\-\,\:]*)*\)\s*)*)(.*)$`)
 	readExp := regexp.MustCompile(`\[([^\]]+)\]`)
 	tagExp := regexp.MustCompile(`[\s\(\),]`)
 
-	var sequence int
-
 	for rows.Next() {
 		var (
 			kanji, kana, entry *string
@@ -107,9 +118,6 @@ func rikaiExtractTerms(rows *sql.Rows) (dbTermList, error) {
 			}
 		}
 
-		rikaiBuildRules(&term)
-		rikaiBuildScore(&term)
-
 		terms = append(terms, term)
 
 		sequence++
@@ -118,22 +126,103 @@ func rikaiExtractTerms(rows *sql.Rows) (dbTermList, error) {
 	return terms, nil
 }
 
-func rikaiExportDb(inputPath, outputPath, language, title string, stride int, pretty bool) error {
+var rikaiNameTags = []dbTag{
+	dbTag{Name: "s", Notes: "surname", Category: "name"},
+	dbTag{Name: "surname", Notes: "surname", Category: "name"},
+	dbTag{Name: "g", Notes: "given name", Category: "name"},
+	dbTag{Name: "given", Notes: "given name", Category: "name"},
+	dbTag{Name: "f", Notes: "female given name", Category: "name"},
+	dbTag{Name: "fem", Notes: "female given name", Category: "name"},
+	dbTag{Name: "m", Notes: "male given name", Category: "name"},
+	dbTag{Name: "masc", Notes: "male given name", Category: "name"},
+	dbTag{Name: "h", Notes: "full name of a particular person", Category: "name"},
+	dbTag{Name: "person", Notes: "full name of a particular person", Category: "name"},
+	dbTag{Name: "p", Notes: "place name", Category: "name"},
+	dbTag{Name: "place", Notes: "place name", Category: "name"},
+	dbTag{Name: "st", Notes: "railway station", Category: "name"},
+	dbTag{Name: "station", Notes: "railway station", Category: "name"},
+	dbTag{Name: "c", Notes: "company name", Category: "name"},
+	dbTag{Name: "company", Notes: "company name", Category: "name"},
+	dbTag{Name: "pr", Notes: "product name", Category: "name"},
+	dbTag{Name: "product", Notes: "product name", Category: "name"},
+	dbTag{Name: "u", Notes: "unclassified name", Category: "name"},
+	dbTag{Name: "unclass", Notes: "unclassified name", Category: "name"},
+}
+
+func isRikaiNameTag(tag string) bool {
+	for _, nameTag := range rikaiNameTags {
+		if nameTag.Name == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Rikaichan names databases store their entries in the same "dict"
+// table as the words databases, so the table holds names when most of
+// its tagged entries are tagged with name types only.
+func isRikaiNameTerms(terms dbTermList) bool {
+	tagged, names := 0, 0
+	for _, term := range terms {
+		if len(term.DefinitionTags) == 0 {
+			continue
+		}
+		tagged++
+		name := true
+		for _, tag := range term.DefinitionTags {
+			name = name && isRikaiNameTag(tag)
+		}
+		if name {
+			names++
+		}
+	}
+	return names*2 > tagged
+}
+
+var rikaiKanjiTags = []dbTag{
+	dbTag{Name: "freq", Notes: "Frequency", Category: "misc"},
+	dbTag{Name: "grade", Notes: "Grade level", Category: "misc"},
+	dbTag{Name: "jlpt", Notes: "JLPT level", Category: "misc"},
+	dbTag{Name: "strokes", Notes: "Stroke count", Category: "misc"},
+	dbTag{Name: "rad_classical", Notes: "Classical (Kangxi) radical number", Category: "radical"},
+	dbTag{Name: "rad_name", Notes: "Radical name", Category: "radical"},
+	dbTag{Name: "skip", Notes: "SKIP code", Category: "class"},
+	dbTag{Name: "ucs", Notes: "Unicode hex code", Category: "code"},
+	dbTag{Name: "halpern_njecd", Notes: "New Japanese-English Character Dictionary", Category: "index"},
+	dbTag{Name: "heisig", Notes: "Remembering The  Kanji", Category: "index"},
+	dbTag{Name: "henshall", Notes: "A Guide To Remembering Japanese Characters", Category: "index"},
+	dbTag{Name: "nelson_c", Notes: "Modern Reader's Japanese-English Character Dictionary", Category: "index"},
+	dbTag{Name: "nelson_n", Notes: "The New Nelson Japanese-English Character Dictionary", Category: "index"},
+}
+
+// Imports every table of the database which is understood and reports
+// the rest. See rikai_schema.go for the supported layouts.
+func rikaiExportDb(inputPath, outputPath, language, title string, stride int, pretty bool, options ExportOptions) error {
 	db, err := sql.Open("sqlite3", inputPath)
 	if err != nil {
 		return err
 	}
 	defer db.Close()
 
-	dictRows, err := db.Query("SELECT kanji, kana, entry FROM dict")
+	tables, err := rikaiInspectSchema(db)
 	if err != nil {
 		return err
 	}
 
-	terms, err := rikaiExtractTerms(dictRows)
+	profile := options.scoringProfile()
+	data, err := rikaiImportTables(db, tables, options.RuleSet, profile)
 	if err != nil {
 		return err
 	}
+	terms := data.terms
+
+	if len(terms) == 0 && len(data.kanji) == 0 {
+		return errors.New("no Rikai dictionary tables found in " + inputPath)
+	}
+
+	if options.ScoreStats {
+		printScoreStats(outputPath, terms, profile)
+	}
 
 	if title == "" {
 		title = "Rikai"
@@ -146,15 +235,27 @@ func rikaiExportDb(inputPath, outputPath, language, title string, stride int, pr
 		dbTag{Name: "arch", Category: "archaism", Order: -4},
 		dbTag{Name: "iK", Category: "archaism", Order: -4},
 	}
+	if data.names {
+		tags = append(tags, rikaiNameTags...)
+	}
+	if len(data.kanji) > 0 {
+		tags = append(tags, rikaiKanjiTags...)
+	}
 
 	recordData := map[string]dbRecordList{
-		"term": terms.crush(),
-		"tag":  tags.crush(),
+		"term":  terms.crush(),
+		"kanji": data.kanji.crush(),
+		"tag":   tags.crush(),
+	}
+
+	revision := "rikai2"
+	if data.tentenData {
+		revision = "10ten"
 	}
 
 	index := dbIndex{
 		Title:     title,
-		Revision:  "rikai2",
+		Revision:  revision,
 		Sequenced: true,
 	}
 
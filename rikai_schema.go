@@ -0,0 +1,507 @@
+package yomitan
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Tables of Rikai-family databases come in two layouts. Rikaichan
+// stores EDICT-formatted lines in "dict" (words or names, depending on
+// the database) and optionally "names" tables, with kanji data in a
+// "kanji" table of KANJIDIC-style fields. 10ten (formerly Rikaichamp)
+// stores JSON-encoded records in "words", "names" and "kanji" tables.
+type rikaiTable struct {
+	name    string
+	columns []string
+}
+
+func (t rikaiTable) hasColumns(columns ...string) bool {
+	for _, column := range columns {
+		if !slices.Contains(t.columns, column) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the first of the candidate column names present in the table.
+func (t rikaiTable) column(candidates ...string) (string, bool) {
+	for _, candidate := range candidates {
+		if slices.Contains(t.columns, candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+func rikaiInspectSchema(db *sql.DB) ([]rikaiTable, error) {
+	rows, err := db.Query("SELECT name FROM sqlite_master WHERE type = 'table' ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tables := []rikaiTable{}
+	for _, name := range names {
+		columnRows, err := db.Query("SELECT name FROM pragma_table_info(?)", name)
+		if err != nil {
+			return nil, err
+		}
+		table := rikaiTable{name: name}
+		for columnRows.Next() {
+			var column string
+			if err := columnRows.Scan(&column); err != nil {
+				columnRows.Close()
+				return nil, err
+			}
+			table.columns = append(table.columns, column)
+		}
+		columnRows.Close()
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// Quotes a table or column name for use in a query.
+func rikaiQuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func isRikaiEdictTable(table rikaiTable) bool {
+	return table.hasColumns("kanji", "kana", "entry")
+}
+
+func isTentenWordTable(table rikaiTable) bool {
+	return table.name == "words" && table.hasColumns("r", "s")
+}
+
+func isTentenNameTable(table rikaiTable) bool {
+	return table.name == "names" && table.hasColumns("r", "tr")
+}
+
+func isTentenKanjiTable(table rikaiTable) bool {
+	return table.name == "kanji" && table.hasColumns("c", "r", "m")
+}
+
+func isRikaiKanjiTable(table rikaiTable) bool {
+	if table.name != "kanji" || !table.hasColumns("kanji") {
+		return false
+	}
+	_, ok := table.column("eigo", "meaning", "meanings")
+	return ok
+}
+
+// Decodes a column holding either a JSON array of strings or a list
+// separated by the given separator.
+func rikaiStringList(value *string, separator string) []string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	var list []string
+	if strings.HasPrefix(*value, "[") && json.Unmarshal([]byte(*value), &list) == nil {
+		return list
+	}
+	list = []string{}
+	for _, item := range strings.Split(*value, separator) {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+type tentenSense struct {
+	Glosses       []string `json:"g"`
+	PartsOfSpeech []string `json:"pos"`
+	Misc          []string `json:"misc"`
+	Field         []string `json:"field"`
+}
+
+type tentenTranslation struct {
+	Types   []string `json:"type"`
+	Details []string `json:"det"`
+}
+
+func tentenHeadwords(kanji, readings []string) [][2]string {
+	headwords := [][2]string{}
+	if len(kanji) == 0 {
+		for _, reading := range readings {
+			headwords = append(headwords, [2]string{reading, reading})
+		}
+		return headwords
+	}
+	for _, reading := range readings {
+		for _, k := range kanji {
+			headwords = append(headwords, [2]string{k, reading})
+		}
+	}
+	return headwords
+}
+
+func tentenExtractWords(rows *sql.Rows, ruleSet string, profile scoringProfile, sequence int) (dbTermList, error) {
+	var terms dbTermList
+	for rows.Next() {
+		var k, r, s *string
+		if err := rows.Scan(&k, &r, &s); err != nil {
+			return nil, err
+		}
+		var senses []tentenSense
+		if s == nil || json.Unmarshal([]byte(*s), &senses) != nil {
+			continue
+		}
+		for _, headword := range tentenHeadwords(rikaiStringList(k, ";"), rikaiStringList(r, ";")) {
+			for _, sense := range senses {
+				term := dbTerm{
+					Expression: headword[0],
+					Reading:    headword[1],
+					Sequence:   sequence,
+				}
+				term.addDefinitionTags(sense.PartsOfSpeech...)
+				term.addDefinitionTags(sense.Misc...)
+				term.addDefinitionTags(sense.Field...)
+				for _, gloss := range sense.Glosses {
+					term.Glossary = append(term.Glossary, gloss)
+				}
+				if len(term.Glossary) == 0 {
+					continue
+				}
+				rikaiBuildRules(&term, ruleSet)
+				rikaiBuildScore(&term, profile)
+				terms = append(terms, term)
+			}
+		}
+		sequence++
+	}
+	return terms, rows.Err()
+}
+
+func tentenExtractNames(rows *sql.Rows, sequence int) (dbTermList, error) {
+	var terms dbTermList
+	for rows.Next() {
+		var k, r, tr *string
+		if err := rows.Scan(&k, &r, &tr); err != nil {
+			return nil, err
+		}
+		var translations []tentenTranslation
+		if tr == nil || json.Unmarshal([]byte(*tr), &translations) != nil {
+			continue
+		}
+		for _, headword := range tentenHeadwords(rikaiStringList(k, ";"), rikaiStringList(r, ";")) {
+			for _, translation := range translations {
+				term := dbTerm{
+					Expression: headword[0],
+					Reading:    headword[1],
+					Sequence:   sequence,
+				}
+				term.addDefinitionTags(translation.Types...)
+				for _, detail := range translation.Details {
+					term.Glossary = append(term.Glossary, detail)
+				}
+				if len(term.Glossary) > 0 {
+					terms = append(terms, term)
+				}
+			}
+		}
+		sequence++
+	}
+	return terms, rows.Err()
+}
+
+type tentenKanjiReadings struct {
+	On     []string `json:"on"`
+	Kun    []string `json:"kun"`
+	Nanori []string `json:"na"`
+}
+
+type tentenKanjiMisc struct {
+	StrokeCount int `json:"sc"`
+	Grade       int `json:"gr"`
+	Frequency   int `json:"freq"`
+	Jlpt        int `json:"jlpt"`
+}
+
+func tentenExtractKanji(rows *sql.Rows, hasMisc bool) (dbKanjiList, error) {
+	var kanji dbKanjiList
+	for rows.Next() {
+		var c, r, m, misc *string
+		dest := []any{&c, &r, &m}
+		if hasMisc {
+			dest = append(dest, &misc)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if c == nil {
+			continue
+		}
+		entry := dbKanji{
+			Character: *c,
+			Meanings:  rikaiStringList(m, ";"),
+			Stats:     map[string]string{},
+		}
+		var readings tentenKanjiReadings
+		if r != nil && json.Unmarshal([]byte(*r), &readings) == nil {
+			entry.Onyomi = readings.On
+			entry.Kunyomi = readings.Kun
+			rikaiAddNanori(&entry, readings.Nanori)
+		}
+		var miscInfo tentenKanjiMisc
+		if misc != nil && json.Unmarshal([]byte(*misc), &miscInfo) == nil {
+			stats := map[string]int{
+				"strokes": miscInfo.StrokeCount,
+				"grade":   miscInfo.Grade,
+				"freq":    miscInfo.Frequency,
+				"jlpt":    miscInfo.Jlpt,
+			}
+			for key, value := range stats {
+				if value != 0 {
+					entry.Stats[key] = strconv.Itoa(value)
+				}
+			}
+		}
+		kanji = append(kanji, entry)
+	}
+	return kanji, rows.Err()
+}
+
+// Rikaichan kanji fields are space-separated codes such as "B7 G8 S7
+// F1509 N43", named after the KANJIDIC fields they were taken from.
+var rikaiKanjiFieldCodes = map[string]string{
+	"B": "rad_classical",
+	"G": "grade",
+	"S": "strokes",
+	"F": "freq",
+	"N": "nelson_c",
+	"V": "nelson_n",
+	"H": "halpern_njecd",
+	"E": "henshall",
+	"L": "heisig",
+	"P": "skip",
+	"U": "ucs",
+}
+
+func rikaiParseKanjiFields(kanji *dbKanji, fields string) {
+	for _, field := range strings.Fields(fields) {
+		if len(field) < 2 {
+			continue
+		}
+		if key, ok := rikaiKanjiFieldCodes[field[:1]]; ok {
+			kanji.Stats[key] = field[1:]
+		}
+	}
+}
+
+// Name readings are only used in names, so they are listed after the
+// meanings rather than among the kun readings.
+func rikaiAddNanori(kanji *dbKanji, nanori []string) {
+	if len(nanori) > 0 {
+		kanji.Meanings = append(kanji.Meanings, "Nanori: "+strings.Join(nanori, ", "))
+	}
+}
+
+// Readings in the combined "onkun" column are on'yomi in katakana and
+// kun'yomi in hiragana. Readings after the "T1" marker are nanori and
+// those after the "T2" marker are names of the radical.
+func rikaiParseOnkun(kanji *dbKanji, onkun string) {
+	marker := ""
+	var nanori, radicalNames []string
+	for _, reading := range strings.Fields(onkun) {
+		switch {
+		case reading == "T1" || reading == "T2":
+			marker = reading
+		case marker == "T1":
+			nanori = append(nanori, reading)
+		case marker == "T2":
+			radicalNames = append(radicalNames, reading)
+		case katakanaToHiragana(reading) != reading:
+			kanji.Onyomi = append(kanji.Onyomi, reading)
+		default:
+			kanji.Kunyomi = append(kanji.Kunyomi, reading)
+		}
+	}
+	rikaiAddNanori(kanji, nanori)
+	if len(radicalNames) > 0 {
+		kanji.Stats["rad_name"] = strings.Join(radicalNames, "、")
+	}
+}
+
+func rikaiExtractKanji(db *sql.DB, table rikaiTable) (dbKanjiList, error) {
+	meaningColumn, _ := table.column("eigo", "meaning", "meanings")
+	columns := []string{"kanji", meaningColumn}
+	optional := []string{}
+	for _, column := range []string{"onkun", "onyomi", "kunyomi", "nanori", "fields"} {
+		if table.hasColumns(column) {
+			optional = append(optional, column)
+		}
+	}
+	columns = append(columns, optional...)
+
+	quoted := []string{}
+	for _, column := range columns {
+		quoted = append(quoted, rikaiQuoteIdentifier(column))
+	}
+	rows, err := db.Query("SELECT " + strings.Join(quoted, ", ") + " FROM " + rikaiQuoteIdentifier(table.name))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var kanji dbKanjiList
+	for rows.Next() {
+		values := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if values[0] == nil {
+			continue
+		}
+		entry := dbKanji{
+			Character: *values[0],
+			Meanings:  rikaiStringList(values[1], ","),
+			Stats:     map[string]string{},
+		}
+		for i, column := range optional {
+			value := values[i+2]
+			if value == nil {
+				continue
+			}
+			switch column {
+			case "onkun":
+				rikaiParseOnkun(&entry, *value)
+			case "onyomi":
+				entry.Onyomi = append(entry.Onyomi, rikaiStringList(value, " ")...)
+			case "kunyomi":
+				entry.Kunyomi = append(entry.Kunyomi, rikaiStringList(value, " ")...)
+			case "nanori":
+				rikaiAddNanori(&entry, rikaiStringList(value, " "))
+			case "fields":
+				rikaiParseKanjiFields(&entry, *value)
+			}
+		}
+		kanji = append(kanji, entry)
+	}
+	return kanji, rows.Err()
+}
+
+type rikaiData struct {
+	terms      dbTermList
+	kanji      dbKanjiList
+	names      bool
+	tentenData bool
+}
+
+func rikaiImportTables(db *sql.DB, tables []rikaiTable, ruleSet string, profile scoringProfile) (rikaiData, error) {
+	var data rikaiData
+	sequence := 0
+	for _, table := range tables {
+		// Sequences are numbered by row rather than by term, so the
+		// next table continues after the last sequence used.
+		if len(data.terms) > 0 {
+			sequence = data.terms[len(data.terms)-1].Sequence + 1
+		}
+		switch {
+		case isRikaiEdictTable(table):
+			rows, err := db.Query("SELECT kanji, kana, entry FROM " + rikaiQuoteIdentifier(table.name))
+			if err != nil {
+				return data, err
+			}
+			terms, err := rikaiExtractTerms(rows, sequence)
+			rows.Close()
+			if err != nil {
+				return data, err
+			}
+			// Names are neither conjugated nor scored like words.
+			if table.name == "names" || isRikaiNameTerms(terms) {
+				data.names = true
+			} else {
+				for i := range terms {
+					rikaiBuildRules(&terms[i], ruleSet)
+					rikaiBuildScore(&terms[i], profile)
+				}
+			}
+			data.terms = append(data.terms, terms...)
+		case isTentenWordTable(table):
+			k := "NULL"
+			if table.hasColumns("k") {
+				k = "k"
+			}
+			rows, err := db.Query("SELECT " + k + ", r, s FROM words")
+			if err != nil {
+				return data, err
+			}
+			terms, err := tentenExtractWords(rows, ruleSet, profile, sequence)
+			rows.Close()
+			if err != nil {
+				return data, err
+			}
+			data.terms = append(data.terms, terms...)
+			data.tentenData = true
+		case isTentenNameTable(table):
+			k := "NULL"
+			if table.hasColumns("k") {
+				k = "k"
+			}
+			rows, err := db.Query("SELECT " + k + ", r, tr FROM names")
+			if err != nil {
+				return data, err
+			}
+			terms, err := tentenExtractNames(rows, sequence)
+			rows.Close()
+			if err != nil {
+				return data, err
+			}
+			data.terms = append(data.terms, terms...)
+			data.names = true
+			data.tentenData = true
+		case isTentenKanjiTable(table):
+			query := "SELECT c, r, m FROM kanji"
+			hasMisc := table.hasColumns("misc")
+			if hasMisc {
+				query = "SELECT c, r, m, misc FROM kanji"
+			}
+			rows, err := db.Query(query)
+			if err != nil {
+				return data, err
+			}
+			kanji, err := tentenExtractKanji(rows, hasMisc)
+			rows.Close()
+			if err != nil {
+				return data, err
+			}
+			data.kanji = append(data.kanji, kanji...)
+			data.tentenData = true
+		case isRikaiKanjiTable(table):
+			kanji, err := rikaiExtractKanji(db, table)
+			if err != nil {
+				return data, err
+			}
+			data.kanji = append(data.kanji, kanji...)
+		case strings.HasPrefix(table.name, "sqlite_"):
+			continue
+		default:
+			fmt.Printf("Unrecognized table \"%s\" with columns: %s\n", table.name, strings.Join(table.columns, ", "))
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,275 @@
+package yomitan
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRikaiTableDetection(t *testing.T) {
+	tests := []struct {
+		name  string
+		table rikaiTable
+		want  string
+	}{
+		{
+			name:  "rikaichan words",
+			table: rikaiTable{name: "dict", columns: []string{"kanji", "kana", "entry"}},
+			want:  "edict",
+		},
+		{
+			name:  "rikaichan names",
+			table: rikaiTable{name: "names", columns: []string{"kanji", "kana", "entry"}},
+			want:  "edict",
+		},
+		{
+			name:  "rikaichan kanji",
+			table: rikaiTable{name: "kanji", columns: []string{"kanji", "onkun", "eigo", "fields"}},
+			want:  "rikai kanji",
+		},
+		{
+			name:  "10ten words",
+			table: rikaiTable{name: "words", columns: []string{"id", "k", "r", "s"}},
+			want:  "10ten words",
+		},
+		{
+			name:  "10ten names",
+			table: rikaiTable{name: "names", columns: []string{"id", "k", "r", "tr"}},
+			want:  "10ten names",
+		},
+		{
+			name:  "10ten kanji",
+			table: rikaiTable{name: "kanji", columns: []string{"c", "r", "m", "misc"}},
+			want:  "10ten kanji",
+		},
+		{
+			name:  "10ten columns in another table",
+			table: rikaiTable{name: "entries", columns: []string{"k", "r", "s"}},
+		},
+		{
+			name:  "kanji table without meanings",
+			table: rikaiTable{name: "kanji", columns: []string{"kanji", "onkun"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got string
+			switch {
+			case isRikaiEdictTable(test.table):
+				got = "edict"
+			case isTentenWordTable(test.table):
+				got = "10ten words"
+			case isTentenNameTable(test.table):
+				got = "10ten names"
+			case isTentenKanjiTable(test.table):
+				got = "10ten kanji"
+			case isRikaiKanjiTable(test.table):
+				got = "rikai kanji"
+			}
+			if got != test.want {
+				t.Errorf("table detected as %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRikaiParseOnkun(t *testing.T) {
+	tests := []struct {
+		name  string
+		onkun string
+		want  dbKanji
+	}{
+		{
+			name:  "on and kun",
+			onkun: "アイ いとしい めでる",
+			want: dbKanji{
+				Onyomi:  []string{"アイ"},
+				Kunyomi: []string{"いとしい", "めでる"},
+				Stats:   map[string]string{},
+			},
+		},
+		{
+			name:  "nanori",
+			onkun: "アイ めでる T1 あき なる",
+			want: dbKanji{
+				Onyomi:   []string{"アイ"},
+				Kunyomi:  []string{"めでる"},
+				Meanings: []string{"Nanori: あき, なる"},
+				Stats:    map[string]string{},
+			},
+		},
+		{
+			name:  "nanori and radical names",
+			onkun: "コウ くち T1 あき T2 くちへん",
+			want: dbKanji{
+				Onyomi:   []string{"コウ"},
+				Kunyomi:  []string{"くち"},
+				Meanings: []string{"Nanori: あき"},
+				Stats:    map[string]string{"rad_name": "くちへん"},
+			},
+		},
+		{
+			name:  "radical names only",
+			onkun: "T2 くちへん くち",
+			want: dbKanji{
+				Stats: map[string]string{"rad_name": "くちへん、くち"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := dbKanji{Stats: map[string]string{}}
+			rikaiParseOnkun(&got, test.onkun)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("rikaiParseOnkun() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRikaiImportTables(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	statements := []string{
+		`CREATE TABLE dict (kanji TEXT, kana TEXT, entry TEXT)`,
+		`INSERT INTO dict VALUES ('愛', 'あい', '愛 [あい] /(n) love/')`,
+		`INSERT INTO dict VALUES (NULL, NULL, NULL)`,
+		`CREATE TABLE "my ""names""" (kanji TEXT, kana TEXT, entry TEXT)`,
+		`INSERT INTO "my ""names""" VALUES ('愛子', 'あいこ', '愛子 [あいこ] /(f) Aiko/')`,
+		`CREATE TABLE words (k TEXT, r TEXT, s TEXT)`,
+		`INSERT INTO words VALUES ('会う', 'あう', '[{"g": ["to meet"], "pos": ["v5u"]}, {"g": ["to see"]}]')`,
+		`INSERT INTO words VALUES (NULL, 'はい', '[{"g": []}]')`,
+		`INSERT INTO words VALUES (NULL, 'いいえ', '[{"g": ["no"]}]')`,
+		`CREATE TABLE zz (kanji TEXT, kana TEXT, entry TEXT)`,
+		`INSERT INTO zz VALUES (NULL, 'ぜっと', 'ぜっと /(n) zed/')`,
+		`CREATE TABLE kanji (c TEXT, r TEXT, m TEXT)`,
+		`INSERT INTO kanji VALUES ('愛', '{"on": ["アイ"], "kun": ["めでる"], "na": ["あき"]}', 'love;affection')`,
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tables, err := rikaiInspectSchema(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rikaiImportTables(db, tables, "", defaultScoringProfile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Tables are imported in name order, and the terms of each row
+	// share a sequence which is not used by any other row.
+	type sequencedTerm struct {
+		expression string
+		sequence   int
+	}
+	var got []sequencedTerm
+	for _, term := range data.terms {
+		got = append(got, sequencedTerm{term.Expression, term.Sequence})
+	}
+	want := []sequencedTerm{
+		{"愛", 0},
+		{"愛子", 1},
+		{"会う", 2},
+		{"会う", 2},
+		{"いいえ", 4},
+		{"ぜっと", 5},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("terms = %v, want %v", got, want)
+	}
+
+	wantKanji := dbKanjiList{{
+		Character: "愛",
+		Onyomi:    []string{"アイ"},
+		Kunyomi:   []string{"めでる"},
+		Meanings:  []string{"love", "affection", "Nanori: あき"},
+		Stats:     map[string]string{},
+	}}
+	if !reflect.DeepEqual(data.kanji, wantKanji) {
+		t.Errorf("kanji = %+v, want %+v", data.kanji, wantKanji)
+	}
+	if !data.tentenData {
+		t.Error("10ten tables were not detected")
+	}
+}
+
+func TestRikaiNamesDatabase(t *testing.T) {
+	tests := []struct {
+		name      string
+		entries   []string
+		wantNames bool
+		wantRules []string
+	}{
+		{
+			name: "words",
+			entries: []string{
+				"会う [あう] /(v5u,vi) to meet/(P)/",
+				"愛 [あい] /(n) love/",
+			},
+			wantRules: []string{"v5", ""},
+		},
+		{
+			name: "names",
+			entries: []string{
+				"愛子 [あいこ] /(f) Aiko/",
+				"上野 [うえの] /(s,p) Ueno/",
+				"会う [あう] /Au/",
+			},
+			wantNames: true,
+			wantRules: []string{"", "", ""},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := sql.Open("sqlite3", ":memory:")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			if _, err := db.Exec(`CREATE TABLE dict (kanji TEXT, kana TEXT, entry TEXT)`); err != nil {
+				t.Fatal(err)
+			}
+			for _, entry := range test.entries {
+				if _, err := db.Exec(`INSERT INTO dict VALUES (NULL, NULL, ?)`, entry); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tables, err := rikaiInspectSchema(db)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := rikaiImportTables(db, tables, "", defaultScoringProfile())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if data.names != test.wantNames {
+				t.Errorf("names = %v, want %v", data.names, test.wantNames)
+			}
+			var rules []string
+			for _, term := range data.terms {
+				rules = append(rules, strings.Join(term.Rules, " "))
+				if test.wantNames && term.Score != 0 {
+					t.Errorf("%s scored %d, want 0", term.Expression, term.Score)
+				}
+			}
+			if !reflect.DeepEqual(rules, test.wantRules) {
+				t.Errorf("rules = %q, want %q", rules, test.wantRules)
+			}
+		})
+	}
+}
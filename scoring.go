@@ -0,0 +1,150 @@
+package yomitan
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// A scoring profile controls how terms are ranked by Yomitan. The
+// default profile reproduces the hard-coded weights used before
+// profiles existed. Profiles are loaded from a JSON file, e.g.
+//
+//	{
+//		"headwordFlags": {"rareKanji": -10},
+//		"tags": {"spec": 1000000, "news1k": 2000000, "arch": -100}
+//	}
+//
+// Headword flag weights are multiplied by the priority weight, while
+// tag weights are added to the score of every term carrying the tag
+// (as either a definition tag or a term tag).
+type scoringProfile struct {
+	SenseWeight         int            `json:"senseWeight"`
+	DepthWeight         int            `json:"depthWeight"`
+	EntryPositionWeight int            `json:"entryPositionWeight"`
+	PriorityWeight      int            `json:"priorityWeight"`
+	HeadwordFlags       map[string]int `json:"headwordFlags"`
+	Tags                map[string]int `json:"tags"`
+}
+
+func defaultHeadwordFlagWeights() map[string]int {
+	return map[string]int{
+		"priority":   1,
+		"frequent":   1,
+		"irregular":  -5,
+		"outdated":   -5,
+		"rareKanji":  -5,
+		"searchOnly": -5,
+		"ateji":      0,
+		"gikun":      0,
+	}
+}
+
+func defaultScoringProfile() scoringProfile {
+	return scoringProfile{
+		SenseWeight:         1,
+		DepthWeight:         100,
+		EntryPositionWeight: 10000,
+		PriorityWeight:      1000000,
+		HeadwordFlags:       defaultHeadwordFlagWeights(),
+		Tags:                map[string]int{},
+	}
+}
+
+func loadScoringProfile(path string) (scoringProfile, error) {
+	profile := defaultScoringProfile()
+	if path == "" {
+		return profile, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return profile, err
+	}
+
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return profile, fmt.Errorf("failed to parse scoring profile '%s': %w", path, err)
+	}
+
+	for flag := range profile.HeadwordFlags {
+		if _, ok := defaultHeadwordFlagWeights()[flag]; !ok {
+			return profile, fmt.Errorf("unknown headword flag '%s' in scoring profile '%s'", flag, path)
+		}
+	}
+
+	return profile, nil
+}
+
+func (p *scoringProfile) tagScore(tagLists ...[]string) int {
+	score := 0
+	counted := []string{}
+	for _, tags := range tagLists {
+		for _, tag := range tags {
+			if slices.Contains(counted, tag) {
+				continue
+			}
+			counted = append(counted, tag)
+			score += p.Tags[tag]
+		}
+	}
+	return score
+}
+
+func calculateTermScore(senseNumber int, depth int, headword headword, term dbTerm, profile scoringProfile) int {
+	score := 0
+	score -= (senseNumber - 1) * profile.SenseWeight
+	score -= depth * profile.DepthWeight
+	score -= headword.Index * profile.EntryPositionWeight
+	score += headword.FlagScore(profile.HeadwordFlags) * profile.PriorityWeight
+	score += profile.tagScore(term.DefinitionTags, term.TermTags)
+
+	return score
+}
+
+func printScoreStats(title string, terms dbTermList, profile scoringProfile) {
+	if len(terms) == 0 {
+		return
+	}
+
+	scores := []int{}
+	for _, term := range terms {
+		scores = append(scores, term.Score)
+	}
+	slices.Sort(scores)
+
+	// Build the report up front so that concurrent batch exports
+	// do not interleave their output.
+	var report strings.Builder
+	fmt.Fprintf(&report, "Score distribution for %s (%d terms)\n", title, len(scores))
+	fmt.Fprintf(&report, "  min: %d, median: %d, max: %d\n", scores[0], scores[len(scores)/2], scores[len(scores)-1])
+	for _, percentile := range []int{10, 25, 75, 90} {
+		fmt.Fprintf(&report, "  p%d: %d\n", percentile, scores[(len(scores)-1)*percentile/100])
+	}
+
+	// Group scores by their priority tier, which is the most
+	// significant component of a profile.
+	tierSize := profile.PriorityWeight
+	if tierSize <= 0 {
+		tierSize = 1
+	}
+	tiers := []int{}
+	tierCounts := map[int]int{}
+	for _, score := range scores {
+		tier := score / tierSize
+		if score < 0 && score%tierSize != 0 {
+			tier -= 1
+		}
+		if tierCounts[tier] == 0 {
+			tiers = append(tiers, tier)
+		}
+		tierCounts[tier] += 1
+	}
+	for _, tier := range tiers {
+		fmt.Fprintf(&report, "  [%d, %d): %d\n", tier*tierSize, (tier+1)*tierSize, tierCounts[tier])
+	}
+
+	fmt.Print(report.String())
+}
@@ -0,0 +1,97 @@
+package yomitan
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadScoringProfile(t *testing.T) {
+	withFlags := func(flags map[string]int) map[string]int {
+		weights := defaultHeadwordFlagWeights()
+		for flag, weight := range flags {
+			weights[flag] = weight
+		}
+		return weights
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		want    scoringProfile
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: `{}`,
+			want: defaultScoringProfile(),
+		},
+		{
+			name: "weights",
+			data: `{"senseWeight": 2, "priorityWeight": 10}`,
+			want: scoringProfile{
+				SenseWeight:         2,
+				DepthWeight:         100,
+				EntryPositionWeight: 10000,
+				PriorityWeight:      10,
+				HeadwordFlags:       defaultHeadwordFlagWeights(),
+				Tags:                map[string]int{},
+			},
+		},
+		{
+			name: "flags and tags",
+			data: `{"headwordFlags": {"rareKanji": -10}, "tags": {"spec": 1000, "arch": -100}}`,
+			want: scoringProfile{
+				SenseWeight:         1,
+				DepthWeight:         100,
+				EntryPositionWeight: 10000,
+				PriorityWeight:      1000000,
+				HeadwordFlags:       withFlags(map[string]int{"rareKanji": -10}),
+				Tags:                map[string]int{"spec": 1000, "arch": -100},
+			},
+		},
+		{
+			name:    "unknown flag",
+			data:    `{"headwordFlags": {"rare": -10}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			data:    `{"tags": [}`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "scoring.json")
+			if err := os.WriteFile(path, []byte(test.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := loadScoringProfile(path)
+			if test.wantErr {
+				if err == nil {
+					t.Error("loadScoringProfile() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("loadScoringProfile() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLoadScoringProfileDefault(t *testing.T) {
+	got, err := loadScoringProfile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := defaultScoringProfile(); !reflect.DeepEqual(got, want) {
+		t.Errorf("loadScoringProfile() = %+v, want %+v", got, want)
+	}
+}
@@ -20,18 +20,7 @@ refresh_source "JMdict_e_examp"
 yomitan -language="english_extra" -title="JMdict" src/JMdict_e_examp dst/jmdict_english_extra_with_examples.zip
 
 refresh_source "JMdict"
-yomitan -language="english_extra" -title="JMdict"         src/JMdict dst/jmdict_english_extra.zip
-yomitan -language="english"   -title="JMdict (English)"   src/JMdict dst/jmdict_english.zip
-yomitan -language="dutch"     -title="JMdict (Dutch)"     src/JMdict dst/jmdict_dutch.zip
-yomitan -language="french"    -title="JMdict (French)"    src/JMdict dst/jmdict_french.zip
-yomitan -language="german"    -title="JMdict (German)"    src/JMdict dst/jmdict_german.zip
-yomitan -language="hungarian" -title="JMdict (Hungarian)" src/JMdict dst/jmdict_hungarian.zip
-yomitan -language="russian"   -title="JMdict (Russian)"   src/JMdict dst/jmdict_russian.zip
-yomitan -language="slovenian" -title="JMdict (Slovenian)" src/JMdict dst/jmdict_slovenian.zip
-yomitan -language="spanish"   -title="JMdict (Spanish)"   src/JMdict dst/jmdict_spanish.zip
-yomitan -language="swedish"   -title="JMdict (Swedish)"   src/JMdict dst/jmdict_swedish.zip
-
-yomitan -format="forms"       -title="JMdict Forms"       src/JMdict dst/jmdict_forms.zip
+yomitan -format="edict_batch" -title="JMdict" src/JMdict dst
 
 refresh_source "JMnedict.xml"
 yomitan src/JMnedict.xml dst/jmnedict.zip
@@ -93,6 +93,31 @@ func contentInternalLink(attr contentAttr, query string, contents ...any) map[st
 	return linkContent
 }
 
+// Images are referenced by their path inside the dictionary archive.
+// Sizes are given in units of "em" so that the image scales with the
+// font size of the popup.
+func contentImage(attr contentAttr, path string, width, height float64, title string) map[string]any {
+	image := map[string]any{
+		"tag":         "img",
+		"path":        path,
+		"width":       width,
+		"height":      height,
+		"sizeUnits":   "em",
+		"collapsed":   false,
+		"collapsible": false,
+	}
+	if title != "" {
+		image["title"] = title
+	}
+	if attr.verticalAlign != "" {
+		image["verticalAlign"] = attr.verticalAlign
+	}
+	if len(attr.data) != 0 {
+		image["data"] = attr.data
+	}
+	return image
+}
+
 func contentSpan(attr contentAttr, contents ...any) map[string]any {
 	return contentStyledContainer(attr, "span", contents...)
 }
@@ -105,6 +105,7 @@ func main() {
 					titleEntry.Text(),
 					yomitan.DefaultStride,
 					yomitan.DefaultPretty,
+					yomitan.ExportOptions{},
 				)
 
 				ui.QueueMain(func() {
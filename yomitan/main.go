@@ -19,22 +19,60 @@ func usage() {
 
 func main() {
 	var (
-		format   = flag.String("format", yomitan.DefaultFormat, "dictionary format [edict|enamdict|epwing|kanjidic|rikai]")
-		language = flag.String("language", yomitan.DefaultLanguage, "dictionary language (if supported)")
+		format   = flag.String("format", yomitan.DefaultFormat, "dictionary format [edict|edict_batch|enamdict|enamdict_components|epwing|kanjidic|kanji_vocab|kanjivg|radkfile|rikai]")
+		language = flag.String("language", yomitan.DefaultLanguage, "dictionary language (if supported); english_extra builds JMdict and JMnedict with structured glossaries")
 		title    = flag.String("title", yomitan.DefaultTitle, "dictionary title")
 		stride   = flag.Int("stride", yomitan.DefaultStride, "dictionary bank stride")
 		pretty   = flag.Bool("pretty", yomitan.DefaultPretty, "output prettified dictionary JSON")
+		rules    = flag.String("rules", yomitan.DefaultRuleSet, "deinflection rule set [legacy|extended]")
+		entry    = flag.Bool("entry", yomitan.DefaultEntryMode, "build one JMdict term per headword with all senses")
+		scoring  = flag.String("scoring", yomitan.DefaultScoring, "path to JSON term scoring profile")
+		stats    = flag.Bool("stats", yomitan.DefaultStats, "print term score distribution")
+		names    = flag.String("names", yomitan.DefaultNameTypes, "comma-separated JMnedict name types or groups [people|places|organizations|works|other]")
+		kanji    = flag.String("kanji", yomitan.DefaultKanjiData, "comma-separated KANJIDIC2 data groups [nanori|radicals|readings|variants|strokes]")
+		gaiji    = flag.String("gaiji", yomitan.DefaultGaiji, "path to JSON gaiji table file or directory for EPWING books")
+		gaijiImg = flag.String("gaiji-images", yomitan.DefaultGaijiImg, "directory of extracted EPWING gaiji images (e.g. w_B021.png)")
+		extract  = flag.String("extractors", yomitan.DefaultExtractors, "path to JSON extractor definition file or directory for EPWING books")
+		subbooks = flag.String("subbooks", yomitan.DefaultSubbooks, "comma-separated titles or indices of the EPWING subbooks to convert")
+		list     = flag.Bool("list-subbooks", yomitan.DefaultListSubbooks, "print the subbooks of an EPWING book with their entry counts")
+		perBook  = flag.Bool("split-subbooks", yomitan.DefaultSplitSubbooks, "write one EPWING dictionary per subbook into the output directory")
+		pitch    = flag.String("pitch", yomitan.DefaultPitch, "export EPWING pitch accent data [include|companion]")
+		plain    = flag.Bool("plain", yomitan.DefaultPlain, "keep EPWING definitions as plain text instead of structured content")
+		skip     = flag.Bool("skip-unknown", yomitan.DefaultSkipUnknown, "skip EPWING subbooks without a dedicated extractor")
+		kanjidic = flag.String("kanjidic", yomitan.DefaultKanjidic, "path to KANJIDIC2 file for the kanji vocabulary dictionary")
+		split    = flag.Bool("split", yomitan.DefaultSplit, "write one JMnedict dictionary per name type group into the output directory")
 	)
 
 	flag.Usage = usage
 	flag.Parse()
 
-	if flag.NArg() != 2 {
+	// Listing subbooks does not need an output path.
+	if flag.NArg() != 2 && !(*list && flag.NArg() == 1) {
 		usage()
 		os.Exit(2)
 	}
 
-	if err := yomitan.ExportDb(flag.Arg(0), flag.Arg(1), *format, *language, *title, *stride, *pretty); err != nil {
+	options := yomitan.ExportOptions{
+		RuleSet:             *rules,
+		EntryMode:           *entry,
+		ScoringPath:         *scoring,
+		ScoreStats:          *stats,
+		NameTypes:           *names,
+		SplitNameTypes:      *split,
+		KanjiData:           *kanji,
+		KanjidicPath:        *kanjidic,
+		GaijiPath:           *gaiji,
+		GaijiImagePath:      *gaijiImg,
+		ExtractorPath:       *extract,
+		Subbooks:            *subbooks,
+		ListSubbooks:        *list,
+		SplitSubbooks:       *perBook,
+		Pitch:               *pitch,
+		SkipUnknownSubbooks: *skip,
+		PlainDefinitions:    *plain,
+	}
+
+	if err := yomitan.ExportDb(flag.Arg(0), flag.Arg(1), *format, *language, *title, *stride, *pretty, options); err != nil {
 		log.Fatal(err)
 	}
 }